@@ -33,12 +33,17 @@ func NewCommand(runtime *cli.Runtime, config *print.Config) *cobra.Command {
 
 	// flags
 	cmd.PersistentFlags().BoolVar(&config.Settings.Anchor, "anchor", true, "create anchor links")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Attributes, "attributes", true, "show Attributes of object inputs")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Default, "default", true, "show Default column or section")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Ephemeral, "ephemeral", true, "show Ephemeral column or section")
 	cmd.PersistentFlags().BoolVar(&config.Settings.HideEmpty, "hide-empty", false, "hide empty sections (default false)")
 	cmd.PersistentFlags().IntVar(&config.Settings.Indent, "indent", 2, "indentation level of AsciiDoc sections [1, 2, 3, 4, 5]")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Nullable, "nullable", true, "show Nullable column or section")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Required, "required", true, "show Required column or section")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Sensitive, "sensitive", true, "show Sensitive column or section")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Source, "source", false, "show Source column or detail of requirements (default false)")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Type, "type", true, "show Type column or section")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Validation, "validation", true, "show Validation column or section")
 
 	// subcommands
 	cmd.AddCommand(document.NewCommand(runtime, config))
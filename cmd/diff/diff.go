@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package diff
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+	"github.com/terraform-docs/terraform-docs/internal/diff"
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+// NewCommand returns a new cobra.Command for 'diff' command
+func NewCommand(runtime *cli.Runtime, config *print.Config) *cobra.Command {
+	var format string
+	var failOnBreaking bool
+
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(2),
+		Use:   "diff [OLD_PATH|GIT_REF] [NEW_PATH]",
+		Short: "Report changes between two versions of a module",
+		Long:  longDescription,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !slices.Contains(diff.Formats, format) {
+				return fmt.Errorf("value of '--format' can only be one of: %s", strings.Join(diff.Formats, ", "))
+			}
+
+			oldPath, newPath := args[0], args[1]
+
+			if err := runtime.ReadConfig(cmd, newPath); err != nil {
+				return err
+			}
+
+			// old version is either a directory or a git reference of the
+			// repository the new version belongs to
+			if info, err := os.Stat(oldPath); err != nil || !info.IsDir() {
+				path, cleanup, err := diff.Checkout(oldPath, newPath)
+				if err != nil {
+					return fmt.Errorf("'%s' is neither a directory nor a git reference: %w", oldPath, err)
+				}
+				defer cleanup()
+				oldPath = path
+			}
+
+			oldModule, err := loadModule(config, oldPath)
+			if err != nil {
+				return err
+			}
+			newModule, err := loadModule(config, newPath)
+			if err != nil {
+				return err
+			}
+
+			report := diff.Compare(oldModule, newModule)
+
+			content, err := report.Render(format)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), content) //nolint:errcheck
+
+			if failOnBreaking && report.Breaking {
+				return fmt.Errorf("breaking changes found")
+			}
+			return nil
+		},
+	}
+
+	// flags
+	cmd.Flags().StringVar(&format, "format", diff.FormatMarkdown, "format of the report ["+strings.Join(diff.Formats, ", ")+"]")
+	cmd.Flags().BoolVar(&failOnBreaking, "fail-on-breaking", false, "exit with non-zero code if there are breaking changes (default false)")
+
+	return cmd
+}
+
+// loadModule loads the module in 'path' with only the items which are
+// compared, i.e. without header, footer, output values and example values.
+func loadModule(config *print.Config, path string) (*terraform.Module, error) {
+	cfg := *config
+	cfg.ModuleRoot = path
+	cfg.Sections.Header = false
+	cfg.Sections.Footer = false
+	cfg.OutputValues.Enabled = false
+	cfg.ExampleValues.Enabled = false
+	return terraform.LoadWithOptions(&cfg)
+}
+
+const longDescription = `Report changes between two versions of a module.
+
+The old version is either a path of a module, or a git reference (e.g. a tag
+or a commit) of the repository the new version belongs to.
+
+Added, removed and renamed inputs and outputs, inputs which became required,
+changed default values and types of inputs, and changed version constraints of
+Terraform and providers are reported, and classified as breaking or
+non-breaking for consumers of the module.`
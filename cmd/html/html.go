@@ -0,0 +1,49 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package html
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+// NewCommand returns a new cobra.Command for 'html' formatter
+func NewCommand(runtime *cli.Runtime, config *print.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cobra.ExactArgs(1),
+		Use:         "html [PATH]",
+		Short:       "Generate standalone HTML page of inputs and outputs",
+		Annotations: cli.Annotations("html"),
+		PreRunE:     runtime.PreRunEFunc,
+		RunE:        runtime.RunEFunc,
+	}
+
+	// flags
+	cmd.PersistentFlags().BoolVar(&config.Settings.Anchor, "anchor", true, "create anchor links")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Attributes, "attributes", true, "show Attributes of object inputs")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Default, "default", true, "show Default column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Ephemeral, "ephemeral", true, "show Ephemeral column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.HideEmpty, "hide-empty", false, "hide empty sections (default false)")
+	cmd.PersistentFlags().IntVar(&config.Settings.Indent, "indent", 2, "heading level of HTML sections [1, 2, 3, 4, 5]")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Nullable, "nullable", true, "show Nullable column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Required, "required", true, "show Required column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Sensitive, "sensitive", true, "show Sensitive column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Source, "source", false, "show Source column of requirements (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Type, "type", true, "show Type column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Validation, "validation", true, "show Validation column")
+
+	cmd.PersistentFlags().StringVar(&config.HTML.Theme, "theme", print.HTMLThemeAuto, "color theme of the page ["+print.HTMLThemes+"]")
+	cmd.PersistentFlags().StringVar(&config.HTML.Stylesheet, "stylesheet", "", "relative path of a CSS file to inline into the page (default \"\")")
+
+	return cmd
+}
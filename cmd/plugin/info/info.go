@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package info
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+	"github.com/terraform-docs/terraform-docs/internal/plugin"
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+// NewCommand returns a new cobra.Command for 'plugin info' command
+func NewCommand(runtime *cli.Runtime, config *print.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.RangeArgs(1, 2),
+		Use:   "info NAME [PATH]",
+		Short: "Launch a plugin and show its version, protocol and capabilities",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rootDir := "."
+			if len(args) > 1 {
+				rootDir = args[1]
+			}
+
+			if err := runtime.ReadConfig(cmd, rootDir); err != nil {
+				return err
+			}
+
+			plugins := plugin.NewList()
+			defer plugins.Clean()
+
+			p, err := plugins.Find(config, args[0])
+			if err != nil {
+				return err
+			}
+
+			info, err := plugins.Info(p)
+			if err != nil {
+				return err
+			}
+
+			checksum := "not set"
+			if info.Checksum != "" {
+				checksum = info.Checksum + " (verified)"
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 1, ' ', 0)
+			fmt.Fprintf(w, "Name:\t%s\n", info.Name)                                     //nolint:errcheck
+			fmt.Fprintf(w, "Version:\t%s\n", info.Version)                               //nolint:errcheck
+			fmt.Fprintf(w, "Source:\t%s\n", info.Source)                                 //nolint:errcheck
+			fmt.Fprintf(w, "Path:\t%s\n", info.Path)                                     //nolint:errcheck
+			fmt.Fprintf(w, "Checksum:\t%s\n", checksum)                                  //nolint:errcheck
+			fmt.Fprintf(w, "Protocol:\t%d\n", info.Protocol)                             //nolint:errcheck
+			fmt.Fprintf(w, "Capabilities:\t%s\n", strings.Join(info.Capabilities, ", ")) //nolint:errcheck
+			return w.Flush()
+		},
+	}
+	return cmd
+}
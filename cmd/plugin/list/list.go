@@ -0,0 +1,54 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package list
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+	"github.com/terraform-docs/terraform-docs/internal/plugin"
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+// NewCommand returns a new cobra.Command for 'plugin list' command
+func NewCommand(runtime *cli.Runtime, config *print.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.MaximumNArgs(1),
+		Use:   "list [PATH]",
+		Short: "List available plugins, without launching them",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rootDir := "."
+			if len(args) > 0 {
+				rootDir = args[0]
+			}
+
+			if err := runtime.ReadConfig(cmd, rootDir); err != nil {
+				return err
+			}
+
+			plugins, err := plugin.All(config)
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tSOURCE\tPATH") //nolint:errcheck
+			for _, p := range plugins {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", p.Name, p.Source, p.Path) //nolint:errcheck
+			}
+			return w.Flush()
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package plugin
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/cmd/plugin/info"
+	"github.com/terraform-docs/terraform-docs/cmd/plugin/list"
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+// NewCommand returns a new cobra.Command for 'plugin' command
+func NewCommand(runtime *cli.Runtime, config *print.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "plugin [command]",
+		Short: "Inspect terraform-docs plugins",
+		Long:  longDescription,
+	}
+
+	// subcommands
+	cmd.AddCommand(list.NewCommand(runtime, config))
+	cmd.AddCommand(info.NewCommand(runtime, config))
+
+	return cmd
+}
+
+const longDescription = `Inspect terraform-docs plugins.
+
+Plugins are declared in 'plugins.sources' of the config file, or looked up in
+the following directories, by priority:
+
+1. $TFDOCS_PLUGIN_DIR (if it's set)
+2. ./.tfdocs.d/plugins
+3. ~/.tfdocs.d/plugins`
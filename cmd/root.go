@@ -18,9 +18,16 @@ import (
 
 	"github.com/terraform-docs/terraform-docs/cmd/asciidoc"
 	"github.com/terraform-docs/terraform-docs/cmd/completion"
+	diffcmd "github.com/terraform-docs/terraform-docs/cmd/diff"
+	"github.com/terraform-docs/terraform-docs/cmd/hclusage"
+	"github.com/terraform-docs/terraform-docs/cmd/html"
 	"github.com/terraform-docs/terraform-docs/cmd/json"
+	"github.com/terraform-docs/terraform-docs/cmd/jsonschema"
 	"github.com/terraform-docs/terraform-docs/cmd/markdown"
+	"github.com/terraform-docs/terraform-docs/cmd/openapi"
+	plugincmd "github.com/terraform-docs/terraform-docs/cmd/plugin"
 	"github.com/terraform-docs/terraform-docs/cmd/pretty"
+	"github.com/terraform-docs/terraform-docs/cmd/rst"
 	"github.com/terraform-docs/terraform-docs/cmd/tfvars"
 	"github.com/terraform-docs/terraform-docs/cmd/toml"
 	versioncmd "github.com/terraform-docs/terraform-docs/cmd/version"
@@ -63,7 +70,14 @@ func NewCommand() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(&config.Recursive.Enabled, "recursive", false, "update submodules recursively (default false)")
 	cmd.PersistentFlags().StringVar(&config.Recursive.Path, "recursive-path", "modules", "submodules path to recursively update")
 	cmd.PersistentFlags().BoolVar(&config.Recursive.IncludeMain, "recursive-include-main", true, "include the main module")
-	cmd.PersistentFlags().StringSliceVar(&config.Recursive.Exclude, "recursive-exclude", []string{}, "exclude directories from recursive update")
+	cmd.PersistentFlags().StringSliceVar(&config.Recursive.Include, "recursive-include", []string{}, "glob patterns of submodules to recursively update, relative to module root")
+	cmd.PersistentFlags().StringSliceVar(&config.Recursive.Exclude, "recursive-exclude", []string{}, "exclude directories (name or glob pattern) from recursive update")
+	cmd.PersistentFlags().BoolVar(&config.Recursive.Gitignore, "recursive-gitignore", true, "skip directories ignored by .gitignore")
+	cmd.PersistentFlags().IntVar(&config.Recursive.MaxDepth, "recursive-max-depth", 0, "max depth of directories to look for submodules (default 0, unlimited)")
+	cmd.PersistentFlags().BoolVar(&config.Recursive.Index.Enabled, "recursive-index", false, "generate index of submodules (default false)")
+	cmd.PersistentFlags().StringVar(&config.Recursive.Index.File, "recursive-index-file", "", "file path to write index of submodules into, relative to module root (default MODULES.md, MODULES.adoc or MODULES.rst based on the formatter)")
+	cmd.PersistentFlags().StringVar(&config.Recursive.Index.Template, "recursive-index-template", "", "template of index of submodules (default \"\")")
+	cmd.PersistentFlags().IntVar(&config.Recursive.Parallelism, "recursive-parallelism", 1, "number of submodules to update concurrently")
 
 	cmd.PersistentFlags().StringSliceVar(&config.Sections.Show, "show", []string{}, "show section ["+print.AllSections+"]")
 	cmd.PersistentFlags().StringSliceVar(&config.Sections.Hide, "hide", []string{}, "hide section ["+print.AllSections+"]")
@@ -72,6 +86,7 @@ func NewCommand() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&config.Output.Mode, "output-mode", "inject", "output to file method ["+print.OutputModes+"]")
 	cmd.PersistentFlags().StringVar(&config.Output.Template, "output-template", print.OutputTemplate, "output template")
 	cmd.PersistentFlags().BoolVar(&config.Output.Check, "output-check", false, "check if content of output file is up to date (default false)")
+	cmd.PersistentFlags().StringVar(&config.Output.CheckReport, "output-check-report", "", "file path to write JSON report of check into (default \"\")")
 
 	cmd.PersistentFlags().BoolVar(&config.Sort.Enabled, "sort", true, "sort items")
 	cmd.PersistentFlags().StringVar(&config.Sort.By, "sort-by", "name", "sort items by criteria ["+print.SortTypes+"]")
@@ -80,17 +95,30 @@ func NewCommand() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&config.FooterFrom, "footer-from", "", "relative path of a file to read footer from (default \"\")")
 
 	cmd.PersistentFlags().BoolVar(&config.Settings.LockFile, "lockfile", true, "read .terraform.lock.hcl if exist")
+	cmd.PersistentFlags().BoolVar(&config.Settings.NestedResources, "nested-resources", false, "load resources of local submodules recursively (default false)")
 
 	cmd.PersistentFlags().BoolVar(&config.OutputValues.Enabled, "output-values", false, "inject output values into outputs (default false)")
 	cmd.PersistentFlags().StringVar(&config.OutputValues.From, "output-values-from", "", "inject output values from file into outputs (default \"\")")
 
+	cmd.PersistentFlags().BoolVar(&config.ExampleValues.Enabled, "example-values", false, "show example values of inputs from tfvars files (default false)")
+	cmd.PersistentFlags().StringSliceVar(&config.ExampleValues.From, "example-values-from", []string{}, "tfvars files or directories to read example values of inputs from")
+
+	cmd.PersistentFlags().StringVar(&config.Usage.Name, "usage-name", "", "name of module block of usage (default name of module directory)")
+	cmd.PersistentFlags().StringVar(&config.Usage.Source, "usage-source", "", "source of module block of usage (default relative path of module)")
+	cmd.PersistentFlags().StringVar(&config.Usage.Version, "usage-version", "", "version of module block of usage (default \"\")")
+
 	cmd.PersistentFlags().BoolVar(&config.Settings.ReadComments, "read-comments", true, "use comments as description when description is empty")
 
 	// formatter subcommands
 	cmd.AddCommand(asciidoc.NewCommand(runtime, config))
+	cmd.AddCommand(hclusage.NewCommand(runtime, config))
+	cmd.AddCommand(html.NewCommand(runtime, config))
 	cmd.AddCommand(json.NewCommand(runtime, config))
+	cmd.AddCommand(jsonschema.NewCommand(runtime, config))
 	cmd.AddCommand(markdown.NewCommand(runtime, config))
+	cmd.AddCommand(openapi.NewCommand(runtime, config))
 	cmd.AddCommand(pretty.NewCommand(runtime, config))
+	cmd.AddCommand(rst.NewCommand(runtime, config))
 	cmd.AddCommand(tfvars.NewCommand(runtime, config))
 	cmd.AddCommand(toml.NewCommand(runtime, config))
 	cmd.AddCommand(xml.NewCommand(runtime, config))
@@ -98,6 +126,8 @@ func NewCommand() *cobra.Command {
 
 	// other subcommands
 	cmd.AddCommand(completion.NewCommand())
+	cmd.AddCommand(diffcmd.NewCommand(runtime, config))
+	cmd.AddCommand(plugincmd.NewCommand(runtime, config))
 	cmd.AddCommand(versioncmd.NewCommand())
 
 	return cmd
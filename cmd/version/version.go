@@ -31,16 +31,14 @@ func NewCommand() *cobra.Command {
 			if err != nil {
 				return
 			}
-			for _, f := range plugins.All() {
-				name, err := f.Name()
-				if err != nil {
-					name = "unknown"
+			list := plugin.NewList()
+			defer list.Clean()
+			for _, p := range plugins {
+				version := "unknown"
+				if info, err := list.Info(p); err == nil {
+					version = info.Version
 				}
-				version, err := f.Version()
-				if err != nil {
-					version = "unknown"
-				}
-				fmt.Printf("- plugin %s %s\n", name, version)
+				fmt.Printf("- plugin %s %s\n", p.Name, version)
 			}
 		},
 	}
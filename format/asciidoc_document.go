@@ -58,6 +58,15 @@ func NewAsciidocDocument(config *print.Config) Type {
 		"isRequired": func() bool {
 			return config.Settings.Required
 		},
+		"validation": func(v *terraform.Validation) string {
+			return printValidation(v, false)
+		},
+		"check": func(c *terraform.Check) string {
+			return printCheck(c, false)
+		},
+		"attribute": func(a *terraform.NestedAttribute) string {
+			return printAttribute(a, config)
+		},
 	})
 
 	return &asciidocDocument{
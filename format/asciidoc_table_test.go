@@ -102,10 +102,62 @@ func TestAsciidocTable(t *testing.T) {
 			}),
 		},
 
+		"WithValidation": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "validations"
+				c.Sections.Inputs = true
+				c.Settings.Default = true
+				c.Settings.Required = true
+				c.Settings.Type = true
+				c.Settings.Validation = true
+			}),
+		},
+		"WithExampleValues": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "example-values"
+				c.Sections.Inputs = true
+				c.Settings.Default = true
+				c.Settings.Required = true
+				c.Settings.Type = true
+				c.ExampleValues.Enabled = true
+			}),
+		},
+
+		"WithInputAttributes": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "attributes"
+				c.Sections.Inputs = true
+				c.Settings.Ephemeral = true
+				c.Settings.Nullable = true
+				c.Settings.Required = true
+				c.Settings.Sensitive = true
+				c.Settings.Type = true
+			}),
+		},
+		"WithObjectAttributes": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "object-attributes"
+				c.Sections.Inputs = true
+				c.Settings.Attributes = true
+				c.Settings.Default = true
+				c.Settings.Ephemeral = true
+				c.Settings.Nullable = true
+				c.Settings.Required = true
+				c.Settings.Sensitive = true
+				c.Settings.Type = true
+			}),
+		},
+
 		// Only section
 		"OnlyDataSources": {
 			config: testutil.With(func(c *print.Config) { c.Sections.DataSources = true }),
 		},
+		"OnlyChecks": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "checks"
+				c.Sections.Checks = true
+			}),
+		},
 		"OnlyHeader": {
 			config: testutil.With(func(c *print.Config) { c.Sections.Header = true }),
 		},
@@ -122,6 +174,12 @@ func TestAsciidocTable(t *testing.T) {
 				c.Settings.Type = true
 			}),
 		},
+		"OnlyMigrations": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "migrations"
+				c.Sections.Migrations = true
+			}),
+		},
 		"OnlyOutputs": {
 			config: testutil.With(func(c *print.Config) { c.Sections.Outputs = true }),
 		},
@@ -131,6 +189,20 @@ func TestAsciidocTable(t *testing.T) {
 		"OnlyProviders": {
 			config: testutil.With(func(c *print.Config) { c.Sections.Providers = true }),
 		},
+		"WithLockFile": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "lock-file"
+				c.Sections.Providers = true
+				c.Settings.LockFile = true
+			}),
+		},
+		"WithRequirements": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "requirements"
+				c.Sections.Requirements = true
+				c.Settings.Source = true
+			}),
+		},
 		"OnlyRequirements": {
 			config: testutil.With(func(c *print.Config) { c.Sections.Requirements = true }),
 		},
@@ -45,6 +45,13 @@ func withFooter(footer string) generateFunc {
 	}
 }
 
+// withChecks specifies how the generator should add Checks.
+func withChecks(checks string) generateFunc {
+	return func(g *generator) {
+		g.checks = checks
+	}
+}
+
 // withInputs specifies how the generator should add Inputs.
 func withInputs(inputs string) generateFunc {
 	return func(g *generator) {
@@ -52,6 +59,13 @@ func withInputs(inputs string) generateFunc {
 	}
 }
 
+// withMigrations specifies how the generator should add Migrations.
+func withMigrations(migrations string) generateFunc {
+	return func(g *generator) {
+		g.migrations = migrations
+	}
+}
+
 // withModules specifies how the generator should add Modules.
 func withModules(modules string) generateFunc {
 	return func(g *generator) {
@@ -116,7 +130,9 @@ type generator struct {
 	// individual sections
 	header       string
 	footer       string
+	checks       string
 	inputs       string
+	migrations   string
 	modules      string
 	outputs      string
 	providers    string
@@ -161,9 +177,15 @@ func (g *generator) Header() string { return g.header }
 // Footer returns generated footer section based on the underlying format.
 func (g *generator) Footer() string { return g.footer }
 
+// Checks returns generated checks section based on the underlying format.
+func (g *generator) Checks() string { return g.checks }
+
 // Inputs returns generated inputs section based on the underlying format.
 func (g *generator) Inputs() string { return g.inputs }
 
+// Migrations returns generated migrations section based on the underlying format.
+func (g *generator) Migrations() string { return g.migrations }
+
 // Modules returns generated modules section based on the underlying format.
 func (g *generator) Modules() string { return g.modules }
 
@@ -217,6 +239,9 @@ func (g *generator) Render(tpl string) (string, error) {
 			}
 			return strings.TrimSuffix(string(content), "\n")
 		},
+		"usage": func() string {
+			return printUsage(g.module, g.config)
+		},
 	})
 
 	data := struct {
@@ -249,7 +274,9 @@ func (g *generator) forEach(render func(string) (string, error)) error {
 		"all":          withContent,
 		"header":       withHeader,
 		"footer":       withFooter,
+		"checks":       withChecks,
 		"inputs":       withInputs,
+		"migrations":   withMigrations,
 		"modules":      withModules,
 		"outputs":      withOutputs,
 		"providers":    withProviders,
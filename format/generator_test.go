@@ -73,6 +73,13 @@ func TestExecuteTemplate(t *testing.T) {
 			expected: "",
 			wantErr:  true,
 		},
+		"Compatible with template usage": {
+			complex:  true,
+			content:  "this is the header\nthis is the footer",
+			template: "{{ usage }}",
+			expected: "module \"format\" {\n  source = \"./\"\n}",
+			wantErr:  false,
+		},
 		"Incompatible without template": {
 			complex:  false,
 			content:  "header: \"this is the header\"\nfooter: \"this is the footer\"",
@@ -98,6 +105,7 @@ func TestExecuteTemplate(t *testing.T) {
 			generator.content = tt.content
 			generator.header = header
 			generator.footer = footer
+			generator.module = &terraform.Module{}
 
 			actual, err := generator.Render(tt.template)
 
@@ -129,10 +137,18 @@ func TestGeneratorFunc(t *testing.T) {
 			fn:     withFooter,
 			actual: func(r *generator) string { return r.footer },
 		},
+		"withChecks": {
+			fn:     withChecks,
+			actual: func(r *generator) string { return r.checks },
+		},
 		"withInputs": {
 			fn:     withInputs,
 			actual: func(r *generator) string { return r.inputs },
 		},
+		"withMigrations": {
+			fn:     withMigrations,
+			actual: func(r *generator) string { return r.migrations },
+		},
 		"withModules": {
 			fn:     withModules,
 			actual: func(r *generator) string { return r.modules },
@@ -209,7 +225,9 @@ func TestForEach(t *testing.T) {
 		"all":          {actual: generator.content},
 		"header":       {actual: generator.header},
 		"footer":       {actual: generator.footer},
+		"checks":       {actual: generator.checks},
 		"inputs":       {actual: generator.inputs},
+		"migrations":   {actual: generator.migrations},
 		"modules":      {actual: generator.modules},
 		"outputs":      {actual: generator.outputs},
 		"providers":    {actual: generator.providers},
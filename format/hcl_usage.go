@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+// hclUsage represents HCL usage format, i.e. a 'module' block calling the
+// Terraform module.
+type hclUsage struct {
+	*generator
+
+	config *print.Config
+}
+
+// NewHCLUsage returns new instance of HCLUsage.
+func NewHCLUsage(config *print.Config) Type {
+	return &hclUsage{
+		generator: newGenerator(config, false),
+		config:    config,
+	}
+}
+
+// Generate a Terraform module as HCL 'module' block.
+func (h *hclUsage) Generate(module *terraform.Module) error {
+	h.funcs(withContent(printUsage(module, h.config)))
+
+	return nil
+}
+
+// printUsage returns a 'module' block calling 'module', with its required
+// inputs first and its optional ones commented out with their default values.
+func printUsage(module *terraform.Module, config *print.Config) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "module %q {\n", usageName(config))
+	if config.Usage.Version != "" {
+		fmt.Fprintf(&b, "  source  = %q\n", usageSource(config))
+		fmt.Fprintf(&b, "  version = %q\n", config.Usage.Version)
+	} else {
+		fmt.Fprintf(&b, "  source = %q\n", usageSource(config))
+	}
+
+	printUsageInputs(&b, module.RequiredInputs, config, "")
+	printUsageInputs(&b, module.OptionalInputs, config, "# ")
+
+	b.WriteString("}")
+
+	return b.String()
+}
+
+// printUsageInputs writes 'inputs' as arguments of the 'module' block, every
+// line of them prefixed with 'prefix'. Required inputs are set to their example
+// value if any, and optional ones to their default value.
+func printUsageInputs(b *strings.Builder, inputs []*terraform.Input, config *print.Config, prefix string) {
+	if len(inputs) == 0 {
+		return
+	}
+
+	padding := alignments(inputs, config)
+	previous := false
+
+	for i, input := range inputs {
+		described := config.Settings.Description && input.Description.Length() > 0
+		if i == 0 || described || previous {
+			b.WriteString("\n")
+		}
+		previous = described
+
+		if described {
+			for _, line := range strings.Split(string(input.Description), "\n") {
+				fmt.Fprintf(b, "  # %s\n", line)
+			}
+		}
+
+		value := input.GetValue()
+		if input.Required && input.HasExample() {
+			value = input.GetExample()
+		}
+		if value == "" {
+			value = `""`
+		}
+
+		argument := fmt.Sprintf("%-*s = %s", padding[i], input.Name, value)
+		for _, line := range strings.Split(argument, "\n") {
+			fmt.Fprintf(b, "  %s%s\n", prefix, line)
+		}
+	}
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// usageName returns the name of the 'module' block, which is 'usage.name' if
+// set, or the name of module root directory otherwise.
+func usageName(config *print.Config) string {
+	if config.Usage.Name != "" {
+		return config.Usage.Name
+	}
+
+	name := invalidNameChars.ReplaceAllString(moduleDirName(config), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') || name[0] == '-' {
+		name = "_" + name
+	}
+
+	return name
+}
+
+// moduleDirName returns the name of module root directory.
+func moduleDirName(config *print.Config) string {
+	root, err := filepath.Abs(config.ModuleRoot)
+	if err != nil {
+		root = config.ModuleRoot
+	}
+	return filepath.Base(root)
+}
+
+// usageSource returns the source of the 'module' block, which is 'usage.source'
+// if set, or the local path of module root relative to current directory
+// otherwise.
+func usageSource(config *print.Config) string {
+	if config.Usage.Source != "" {
+		return config.Usage.Source
+	}
+
+	source := filepath.Clean(config.ModuleRoot)
+	if filepath.IsAbs(source) {
+		if wd, err := os.Getwd(); err == nil {
+			if rel, err := filepath.Rel(wd, source); err == nil {
+				source = rel
+			}
+		}
+	}
+
+	source = filepath.ToSlash(source)
+	switch {
+	case source == ".":
+		return "./"
+	case source == "..", strings.HasPrefix(source, "../"), strings.HasPrefix(source, "/"):
+		return source
+	default:
+		return "./" + source
+	}
+}
+
+func init() {
+	register(map[string]initializerFn{
+		"hcl-usage": NewHCLUsage,
+	})
+}
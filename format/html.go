@@ -0,0 +1,322 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"embed"
+	"fmt"
+	"html"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	gotemplate "text/template"
+
+	"mvdan.cc/xurls/v2"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/template"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+//go:embed templates/html*.tmpl
+var htmlFS embed.FS
+
+//go:embed templates/html.css
+var htmlStyle string
+
+//go:embed templates/html.js
+var htmlScript string
+
+// htmlPage represents HTML format, a standalone single page with inline
+// styles and scripts.
+type htmlPage struct {
+	*generator
+
+	config   *print.Config
+	template *template.Template
+}
+
+// NewHTML returns new instance of HTML.
+func NewHTML(config *print.Config) Type {
+	items := readTemplateItems(htmlFS, "html")
+
+	tt := template.New(config, items...)
+	tt.CustomFunc(gotemplate.FuncMap{
+		"heading": func(extra int) int {
+			return min(max(config.Settings.Indent+extra, 1), 6)
+		},
+		"escape": html.EscapeString,
+		"safeURL": func(link string) string {
+			if !isSafeHTMLURL(link) {
+				return ""
+			}
+			return link
+		},
+		"text": printHTMLText,
+		"type": func(t string) string {
+			return printHTMLCode(t)
+		},
+		"value": func(v string) string {
+			if v == "" {
+				return "n/a"
+			}
+			return printHTMLCode(v)
+		},
+		"validation": func(v *terraform.Validation) string {
+			condition := strings.Join(strings.Fields(v.Condition), " ")
+			return fmt.Sprintf("<code>%s</code>: %s", html.EscapeString(condition), printHTMLInline(strings.TrimSpace(string(v.ErrorMessage))))
+		},
+		"check": func(c *terraform.Check) string {
+			condition := strings.Join(strings.Fields(c.Condition), " ")
+			return fmt.Sprintf("<code>%s</code>: %s", html.EscapeString(condition), printHTMLInline(strings.TrimSpace(string(c.ErrorMessage))))
+		},
+	})
+
+	return &htmlPage{
+		generator: newGenerator(config, true),
+		config:    config,
+		template:  tt,
+	}
+}
+
+// Generate a Terraform module as HTML page.
+func (h *htmlPage) Generate(module *terraform.Module) error {
+	err := h.forEach(func(name string) (string, error) {
+		rendered, err := h.template.Render(name, module)
+		if err != nil {
+			return "", err
+		}
+		return sanitize(rendered), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	h.funcs(withModule(module))
+
+	page, err := h.page(h.content)
+	if err != nil {
+		return err
+	}
+
+	h.funcs(withContent(page))
+
+	return nil
+}
+
+// Render renders the 'tpl' content template, if any, as body of the page.
+func (h *htmlPage) Render(tpl string) (string, error) {
+	if tpl == "" {
+		return h.content, nil
+	}
+
+	body, err := h.generator.Render(tpl)
+	if err != nil {
+		return "", err
+	}
+
+	return h.page(body)
+}
+
+// page wraps 'body' in a standalone HTML page, with the built-in styles and
+// the ones of 'html.stylesheet' inlined.
+func (h *htmlPage) page(body string) (string, error) {
+	style := htmlStyle
+	if h.config.HTML.Stylesheet != "" {
+		content, err := os.ReadFile(filepath.Join(h.config.ModuleRoot, filepath.Clean(h.config.HTML.Stylesheet)))
+		if err != nil {
+			return "", err
+		}
+		style += "\n" + string(content)
+	}
+
+	theme := h.config.HTML.Theme
+	if theme == "" {
+		theme = print.HTMLThemeAuto
+	}
+
+	rendered, err := h.template.RenderContent("page", struct {
+		Title  string
+		Theme  string
+		Style  string
+		Script string
+		Body   string
+	}{
+		Title:  html.EscapeString(moduleDirName(h.config)),
+		Theme:  theme,
+		Style:  strings.TrimSuffix(style, "\n"),
+		Script: strings.TrimSuffix(htmlScript, "\n"),
+		Body:   body,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(rendered, "\n"), nil
+}
+
+// printHTMLCode prints 'code' inline if it's a single line, otherwise as a
+// collapsible block with its first line as summary.
+func printHTMLCode(code string) string {
+	if !strings.Contains(code, "\n") {
+		return fmt.Sprintf("<code>%s</code>", html.EscapeString(code))
+	}
+	summary, _, _ := strings.Cut(code, "\n")
+	return fmt.Sprintf("<details><summary><code>%s</code></summary><pre><code>%s</code></pre></details>", html.EscapeString(summary+" …"), html.EscapeString(code))
+}
+
+var (
+	htmlHeadingRegex  = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*$`)
+	htmlListItemRegex = regexp.MustCompile(`^[-*+]\s+(.*)$`)
+)
+
+// printHTMLText converts text 's' (e.g. description, header or footer) from
+// the basic Markdown syntax into HTML. Only headings, paragraphs, lists, code
+// blocks, code spans and links are supported, and everything else is escaped.
+// A single paragraph is returned as is, without being wrapped in <p> tag.
+func printHTMLText(s string) string {
+	blocks := []string{}
+	paragraph := []string{}
+	list := []string{}
+
+	flush := func() {
+		if len(paragraph) > 0 {
+			blocks = append(blocks, "<p>"+printHTMLInline(strings.Join(paragraph, "\n"))+"</p>")
+			paragraph = []string{}
+		}
+		if len(list) > 0 {
+			blocks = append(blocks, "<ul>\n<li>"+strings.Join(list, "</li>\n<li>")+"</li>\n</ul>")
+			list = []string{}
+		}
+	}
+
+	lines := strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+
+		switch {
+		case strings.HasPrefix(line, "```"):
+			flush()
+			code := []string{}
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```"); i++ {
+				code = append(code, lines[i])
+			}
+			blocks = append(blocks, "<pre><code>"+html.EscapeString(strings.Join(code, "\n"))+"</code></pre>")
+		case line == "":
+			flush()
+		case htmlHeadingRegex.MatchString(line):
+			flush()
+			match := htmlHeadingRegex.FindStringSubmatch(line)
+			blocks = append(blocks, fmt.Sprintf("<h%d>%s</h%d>", len(match[1]), printHTMLInline(match[2]), len(match[1])))
+		case htmlListItemRegex.MatchString(line):
+			if len(paragraph) > 0 {
+				flush()
+			}
+			list = append(list, printHTMLInline(htmlListItemRegex.FindStringSubmatch(line)[1]))
+		default:
+			if len(list) > 0 {
+				flush()
+			}
+			paragraph = append(paragraph, line)
+		}
+	}
+	flush()
+
+	if len(blocks) == 1 && strings.HasPrefix(blocks[0], "<p>") {
+		return strings.TrimSuffix(strings.TrimPrefix(blocks[0], "<p>"), "</p>")
+	}
+
+	return strings.Join(blocks, "\n")
+}
+
+var htmlLinkRegex = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+
+// printHTMLInline escapes 's' and converts its code spans and links to HTML.
+func printHTMLInline(s string) string {
+	var b strings.Builder
+
+	segments := strings.Split(s, "`")
+	for i, segment := range segments {
+		// odd segments are code spans, if they're closed
+		if i%2 == 1 && i < len(segments)-1 {
+			b.WriteString("<code>" + html.EscapeString(segment) + "</code>")
+			continue
+		}
+		if i%2 == 1 {
+			b.WriteString("`")
+		}
+		b.WriteString(printHTMLLinks(segment))
+	}
+
+	return b.String()
+}
+
+// printHTMLLinks escapes 's' and converts its Markdown links and bare URLs to
+// HTML links. Links with unsafe URLs (e.g. 'javascript:') are kept as text.
+func printHTMLLinks(s string) string {
+	var b strings.Builder
+
+	last := 0
+	for _, match := range htmlLinkRegex.FindAllStringSubmatchIndex(s, -1) {
+		b.WriteString(printHTMLURLs(s[last:match[0]]))
+		text, link := s[match[2]:match[3]], s[match[4]:match[5]]
+		if isSafeHTMLURL(link) {
+			fmt.Fprintf(&b, "<a href=\"%s\">%s</a>", html.EscapeString(link), html.EscapeString(text))
+		} else {
+			b.WriteString(html.EscapeString(s[match[0]:match[1]]))
+		}
+		last = match[1]
+	}
+	b.WriteString(printHTMLURLs(s[last:]))
+
+	return b.String()
+}
+
+// printHTMLURLs escapes 's' and converts its bare URLs to HTML links.
+func printHTMLURLs(s string) string {
+	var b strings.Builder
+
+	last := 0
+	for _, match := range xurls.Strict().FindAllStringIndex(s, -1) {
+		link := s[match[0]:match[1]]
+		if !isSafeHTMLURL(link) {
+			continue
+		}
+		b.WriteString(html.EscapeString(s[last:match[0]]))
+		fmt.Fprintf(&b, "<a href=\"%s\">%s</a>", html.EscapeString(link), html.EscapeString(link))
+		last = match[1]
+	}
+	b.WriteString(html.EscapeString(s[last:]))
+
+	return b.String()
+}
+
+// isSafeHTMLURL returns whether 'link' can be used as href of a link, i.e. it
+// is either an http, https or mailto URL, an anchor or a relative URL.
+func isSafeHTMLURL(link string) bool {
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "", "http", "https", "mailto":
+		return true
+	}
+	return false
+}
+
+func init() {
+	register(map[string]initializerFn{
+		"html": NewHTML,
+	})
+}
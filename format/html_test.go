@@ -0,0 +1,295 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+func TestHTML(t *testing.T) {
+	tests := map[string]struct {
+		config print.Config
+	}{
+		// Base
+		"Base": {
+			config: testutil.WithSections(),
+		},
+		"Empty": {
+			config: testutil.WithDefaultSections(
+				testutil.With(func(c *print.Config) {
+					c.ModuleRoot = "empty"
+				}),
+			),
+		},
+		"HideEmpty": {
+			config: testutil.WithDefaultSections(
+				testutil.WithHideEmpty(),
+				testutil.With(func(c *print.Config) {
+					c.ModuleRoot = "empty"
+				}),
+			),
+		},
+
+		// Settings
+		"WithAnchor": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Settings.Anchor = true
+				}),
+			),
+		},
+		"WithRequired": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Settings.Required = true
+				}),
+			),
+		},
+		"IndentationOfFour": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Settings.Indent = 4
+				}),
+			),
+		},
+		"OutputValues": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Outputs = true
+				c.OutputValues.Enabled = true
+				c.OutputValues.From = "output_values.json"
+				c.Settings.Sensitive = true
+			}),
+		},
+		"WithValidation": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "validations"
+				c.Sections.Inputs = true
+				c.Settings.Default = true
+				c.Settings.Required = true
+				c.Settings.Type = true
+				c.Settings.Validation = true
+			}),
+		},
+		"WithExampleValues": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "example-values"
+				c.Sections.Inputs = true
+				c.Settings.Default = true
+				c.Settings.Required = true
+				c.Settings.Type = true
+				c.ExampleValues.Enabled = true
+			}),
+		},
+		"WithObjectAttributes": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "object-attributes"
+				c.Sections.Inputs = true
+				c.Settings.Attributes = true
+				c.Settings.Default = true
+				c.Settings.Required = true
+				c.Settings.Type = true
+			}),
+		},
+		"DarkTheme": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "empty"
+				c.Sections.Inputs = true
+				c.HTML.Theme = print.HTMLThemeDark
+			}),
+		},
+		"WithStylesheet": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "stylesheet"
+				c.Sections.Inputs = true
+				c.HTML.Stylesheet = "style.css"
+			}),
+		},
+
+		// Only section
+		"OnlyChecks": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "checks"
+				c.Sections.Checks = true
+			}),
+		},
+		"OnlyHeader": {
+			config: testutil.With(func(c *print.Config) { c.Sections.Header = true }),
+		},
+		"OnlyFooter": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Footer = true
+				c.FooterFrom = "footer.md"
+			}),
+		},
+		"OnlyInputs": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Inputs = true
+				c.Settings.Default = true
+				c.Settings.Type = true
+			}),
+		},
+		"OnlyMigrations": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "migrations"
+				c.Sections.Migrations = true
+			}),
+		},
+		"OnlyOutputs": {
+			config: testutil.With(func(c *print.Config) { c.Sections.Outputs = true }),
+		},
+		"OnlyModulecalls": {
+			config: testutil.With(func(c *print.Config) { c.Sections.ModuleCalls = true }),
+		},
+		"OnlyProviders": {
+			config: testutil.With(func(c *print.Config) { c.Sections.Providers = true }),
+		},
+		"WithLockFile": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "lock-file"
+				c.Sections.Providers = true
+				c.Settings.LockFile = true
+			}),
+		},
+		"WithRequirements": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "requirements"
+				c.Sections.Requirements = true
+				c.Settings.Source = true
+			}),
+		},
+		"OnlyRequirements": {
+			config: testutil.With(func(c *print.Config) { c.Sections.Requirements = true }),
+		},
+		"OnlyResources": {
+			config: testutil.With(func(c *print.Config) { c.Sections.Resources = true }),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			expected, err := testutil.GetExpected("html", "html-"+name)
+			assert.Nil(err)
+
+			module, err := testutil.GetModule(&tt.config)
+			assert.Nil(err)
+
+			formatter := NewHTML(&tt.config)
+
+			err = formatter.Generate(module)
+			assert.Nil(err)
+
+			assert.Equal(expected, formatter.Content())
+		})
+	}
+}
+
+func TestHTMLRender(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.ModuleRoot = "empty"
+		c.Sections.Inputs = true
+	})
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	formatter := NewHTML(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	actual, err := formatter.Render("<h1>Custom</h1>\n{{ .Inputs }}")
+	assert.Nil(err)
+
+	assert.Contains(actual, "<main>\n<h1>Custom</h1>\n<section id=\"inputs\">")
+	assert.Contains(actual, "<title>empty</title>")
+}
+
+func TestHTMLUnsafeURL(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.With(func(c *print.Config) {
+		c.Sections.Providers = true
+		c.Sections.Resources = true
+		c.Sections.DataSources = true
+	})
+	config.Registry.AddProvider("aws", "javascript:alert(1)", "javascript:alert('{{ .Type }}')")
+	config.Registry.AddProvider("null", "https://example.com/null", "https://example.com/null/{{ .Type }}")
+
+	module, err := testutil.GetModule(&config)
+	assert.Nil(err)
+
+	terraform.AttachRegistry(module, &config)
+
+	formatter := NewHTML(&config)
+
+	err = formatter.Generate(module)
+	assert.Nil(err)
+
+	actual := formatter.Content()
+
+	assert.NotContains(actual, "javascript:")
+	assert.Contains(actual, "<tr><td>aws_caller_identity.current</td><td>data source</td></tr>")
+	assert.Contains(actual, "<a href=\"https://example.com/null/resource\">null_resource.foo</a>")
+}
+
+func TestPrintHTMLText(t *testing.T) {
+	tests := map[string]struct {
+		text     string
+		expected string
+	}{
+		"Empty": {
+			text:     "",
+			expected: "",
+		},
+		"Paragraph": {
+			text:     "It's <b>bold</b> & `code`.",
+			expected: "It&#39;s &lt;b&gt;bold&lt;/b&gt; &amp; <code>code</code>.",
+		},
+		"UnclosedCode": {
+			text:     "It's `code",
+			expected: "It&#39;s `code",
+		},
+		"Links": {
+			text:     "See [docs](https://example.com/docs) or https://example.com.",
+			expected: "See <a href=\"https://example.com/docs\">docs</a> or <a href=\"https://example.com\">https://example.com</a>.",
+		},
+		"RelativeLinks": {
+			text:     "See [usage](#usage), [docs](docs/README.md) or [us](mailto:team@example.com).",
+			expected: "See <a href=\"#usage\">usage</a>, <a href=\"docs/README.md\">docs</a> or <a href=\"mailto:team@example.com\">us</a>.",
+		},
+		"UnsafeLinks": {
+			text:     "Click [x](javascript:alert(1)) or [y](JavaScript:alert(1)) or [z](data:text/html;base64,PHNjcmlwdD4=).",
+			expected: "Click [x](javascript:alert(1)) or [y](JavaScript:alert(1)) or [z](data:text/html;base64,PHNjcmlwdD4=).",
+		},
+		"Blocks": {
+			text:     "# Title\n\nFirst\nparagraph.\n\n- one\n- `two`\n\n```hcl\nfoo = \"bar\"\n```",
+			expected: "<h1>Title</h1>\n<p>First\nparagraph.</p>\n<ul>\n<li>one</li>\n<li><code>two</code></li>\n</ul>\n<pre><code>foo = &#34;bar&#34;</code></pre>",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := printHTMLText(tt.text)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
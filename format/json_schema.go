@@ -0,0 +1,478 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"bytes"
+	jsonsdk "encoding/json"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/iancoleman/orderedmap"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+// jsonSchemaDraft is the JSON Schema dialect of the generated document.
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchema represents JSON Schema format, i.e. a schema of the inputs of the
+// module to validate tfvars files (or any equivalent document) against.
+type jsonSchema struct {
+	*generator
+
+	config *print.Config
+}
+
+// NewJSONSchema returns new instance of JSONSchema.
+func NewJSONSchema(config *print.Config) Type {
+	return &jsonSchema{
+		generator: newGenerator(config, false),
+		config:    config,
+	}
+}
+
+// Generate a Terraform module as JSON Schema of its inputs.
+func (j *jsonSchema) Generate(module *terraform.Module) error {
+	schema := newSchema()
+	schema.Set("$schema", jsonSchemaDraft)
+	schema.Set("title", moduleDirName(j.config))
+	mergeSchema(schema, inputsSchema(module.Inputs))
+
+	buffer := new(bytes.Buffer)
+	encoder := jsonsdk.NewEncoder(buffer)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(j.config.Settings.Escape)
+
+	if err := encoder.Encode(schema); err != nil {
+		return err
+	}
+
+	j.funcs(withContent(strings.TrimSuffix(buffer.String(), "\n")))
+
+	return nil
+}
+
+func newSchema() *orderedmap.OrderedMap {
+	schema := orderedmap.New()
+	schema.SetEscapeHTML(false)
+	return schema
+}
+
+// inputsSchema returns the schema of an object of all the 'inputs', which
+// doesn't allow any other property.
+func inputsSchema(inputs []*terraform.Input) *orderedmap.OrderedMap {
+	properties := newSchema()
+	required := []string{}
+	for _, i := range inputs {
+		properties.Set(i.Name, inputSchema(i))
+		if i.Required {
+			required = append(required, i.Name)
+		}
+	}
+
+	schema := newSchema()
+	schema.Set("type", "object")
+	schema.Set("properties", properties)
+	if len(required) > 0 {
+		schema.Set("required", required)
+	}
+	schema.Set("additionalProperties", false)
+	return schema
+}
+
+// inputSchema returns the schema of input 'i' with its description, type
+// constraint, default and example values, and the keywords equivalent to its
+// validation rules where possible.
+func inputSchema(i *terraform.Input) *orderedmap.OrderedMap {
+	schema := newSchema()
+	if i.Description != "" {
+		schema.Set("description", string(i.Description))
+	}
+
+	typeSchema(schema, i.TypeSchema)
+	if t, ok := schema.Get("type"); ok && i.Nullable {
+		schema.Set("type", []interface{}{t, "null"})
+	}
+
+	if !i.Required {
+		schema.Set("default", i.Default)
+	}
+	if i.HasExample() {
+		schema.Set("examples", []interface{}{i.Example})
+	}
+
+	rules := []interface{}{}
+	for _, v := range i.Validations {
+		rule := validationSchema(v, i)
+		if rule == nil {
+			continue
+		}
+		if !mergeSchema(schema, rule) {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) > 0 {
+		schema.Set("allOf", rules)
+	}
+
+	return schema
+}
+
+// typeSchema sets the keywords of type constraint 't' into 'schema'. A nil type
+// constraint (e.g. 'any') doesn't set anything, i.e. accepts any value.
+func typeSchema(schema *orderedmap.OrderedMap, t *terraform.TypeSchema) {
+	if t == nil {
+		return
+	}
+	switch t.Kind {
+	case terraform.TypeString:
+		schema.Set("type", "string")
+	case terraform.TypeNumber:
+		schema.Set("type", "number")
+	case terraform.TypeBool:
+		schema.Set("type", "boolean")
+	case terraform.TypeList, terraform.TypeSet:
+		schema.Set("type", "array")
+		schema.Set("items", elementSchema(t.Element))
+		if t.Kind == terraform.TypeSet {
+			schema.Set("uniqueItems", true)
+		}
+	case terraform.TypeMap:
+		schema.Set("type", "object")
+		schema.Set("additionalProperties", elementSchema(t.Element))
+	case terraform.TypeTuple:
+		elements := make([]interface{}, 0, len(t.Elements))
+		for _, e := range t.Elements {
+			elements = append(elements, elementSchema(e))
+		}
+		schema.Set("type", "array")
+		schema.Set("prefixItems", elements)
+		schema.Set("items", false)
+		schema.Set("minItems", len(elements))
+	case terraform.TypeObject:
+		properties := newSchema()
+		required := []string{}
+		for _, a := range t.Attributes {
+			attribute := newSchema()
+			if a.Description != "" {
+				attribute.Set("description", string(a.Description))
+			}
+			typeSchema(attribute, a.Type)
+			if a.Optional {
+				if a.Default != nil {
+					attribute.Set("default", a.Default)
+				}
+			} else {
+				required = append(required, a.Name)
+			}
+			properties.Set(a.Name, attribute)
+		}
+		schema.Set("type", "object")
+		schema.Set("properties", properties)
+		if len(required) > 0 {
+			schema.Set("required", required)
+		}
+	}
+}
+
+func elementSchema(t *terraform.TypeSchema) *orderedmap.OrderedMap {
+	schema := newSchema()
+	typeSchema(schema, t)
+	return schema
+}
+
+// mergeSchema merges the keywords of 'src' into 'dst' if none of them is
+// already set in 'dst', and returns whether it did.
+func mergeSchema(dst *orderedmap.OrderedMap, src *orderedmap.OrderedMap) bool {
+	for _, k := range src.Keys() {
+		if _, ok := dst.Get(k); ok {
+			return false
+		}
+	}
+	for _, k := range src.Keys() {
+		v, _ := src.Get(k)
+		dst.Set(k, v)
+	}
+	return true
+}
+
+// validationSchema returns the schema equivalent to the condition of
+// validation rule 'v' of input 'i', or nil if it can't be expressed in JSON
+// Schema (e.g. it references other variables or uses unsupported functions).
+func validationSchema(v *terraform.Validation, i *terraform.Input) *orderedmap.OrderedMap {
+	expr, diags := hclsyntax.ParseExpression([]byte(v.Condition), "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil
+	}
+	return conditionSchema(expr, i, false)
+}
+
+// conditionSchema returns the schema equivalent to 'expr' for input 'i'. The
+// supported conditions are:
+//
+//   - comparisons of the input with a literal, e.g. 'var.x > 0'
+//   - comparisons of the length of the input, e.g. 'length(var.x) <= 10'
+//   - regular expressions, e.g. 'can(regex("^[a-z]+$", var.x))'
+//   - allowed values, e.g. 'contains(["a", "b"], var.x)'
+//   - any combination of the above with '&&', '||' and '!'
+//
+// 'negated' is whether 'expr' is negated by an odd number of '!' around it,
+// where a weaker schema than the condition would be a stricter one.
+func conditionSchema(expr hclsyntax.Expression, i *terraform.Input, negated bool) *orderedmap.OrderedMap {
+	switch e := expr.(type) {
+	case *hclsyntax.ParenthesesExpr:
+		return conditionSchema(e.Expression, i, negated)
+	case *hclsyntax.UnaryOpExpr:
+		if e.Op != hclsyntax.OpLogicalNot {
+			return nil
+		}
+		if inner := conditionSchema(e.Val, i, !negated); inner != nil {
+			schema := newSchema()
+			schema.Set("not", inner)
+			return schema
+		}
+	case *hclsyntax.BinaryOpExpr:
+		return binaryOpSchema(e, i, negated)
+	case *hclsyntax.FunctionCallExpr:
+		return functionCallSchema(e, i)
+	}
+	return nil
+}
+
+func binaryOpSchema(e *hclsyntax.BinaryOpExpr, i *terraform.Input, negated bool) *orderedmap.OrderedMap {
+	switch e.Op {
+	case hclsyntax.OpLogicalAnd, hclsyntax.OpLogicalOr:
+		lhs, rhs := conditionSchema(e.LHS, i, negated), conditionSchema(e.RHS, i, negated)
+		if e.Op == hclsyntax.OpLogicalAnd {
+			// a weaker schema is still valid, if only one side is supported,
+			// unless it's negated
+			switch {
+			case (lhs == nil || rhs == nil) && negated:
+				return nil
+			case lhs == nil:
+				return rhs
+			case rhs == nil:
+				return lhs
+			}
+			schema := newSchema()
+			if mergeSchema(schema, lhs) && mergeSchema(schema, rhs) {
+				return schema
+			}
+			schema = newSchema()
+			schema.Set("allOf", []interface{}{lhs, rhs})
+			return schema
+		}
+		if lhs == nil || rhs == nil {
+			return nil
+		}
+		schema := newSchema()
+		schema.Set("anyOf", []interface{}{lhs, rhs})
+		return schema
+	}
+
+	// comparison of the input (or its length) with a literal, in any order
+	op, subject, literal := e.Op, e.LHS, e.RHS
+	if _, ok := literalValue(subject); ok {
+		op, subject, literal = flipOperation(e.Op), e.RHS, e.LHS
+	}
+	value, ok := literalValue(literal)
+	if !ok || op == nil {
+		return nil
+	}
+
+	if isInputReference(subject, i) {
+		return comparisonSchema(op, value)
+	}
+	if call, ok := subject.(*hclsyntax.FunctionCallExpr); ok && call.Name == "length" && len(call.Args) == 1 {
+		if isInputReference(call.Args[0], i) {
+			return lengthSchema(op, value, i.TypeSchema)
+		}
+		// length(regexall("pattern", var.x)) > 0
+		if pattern := regexPattern(call.Args[0], "regexall", i); pattern != "" {
+			if n, ok := value.(jsonsdk.Number); ok && (op == hclsyntax.OpGreaterThan && n.String() == "0" || op == hclsyntax.OpGreaterThanOrEqual && n.String() == "1") {
+				schema := newSchema()
+				schema.Set("pattern", pattern)
+				return schema
+			}
+		}
+	}
+	return nil
+}
+
+// flipOperation returns the comparison operation equivalent to 'op' when its
+// operands are swapped, e.g. '0 < var.x' is 'var.x > 0'.
+func flipOperation(op *hclsyntax.Operation) *hclsyntax.Operation {
+	switch op {
+	case hclsyntax.OpGreaterThan:
+		return hclsyntax.OpLessThan
+	case hclsyntax.OpGreaterThanOrEqual:
+		return hclsyntax.OpLessThanOrEqual
+	case hclsyntax.OpLessThan:
+		return hclsyntax.OpGreaterThan
+	case hclsyntax.OpLessThanOrEqual:
+		return hclsyntax.OpGreaterThanOrEqual
+	case hclsyntax.OpEqual, hclsyntax.OpNotEqual:
+		return op
+	}
+	return nil
+}
+
+func comparisonSchema(op *hclsyntax.Operation, value interface{}) *orderedmap.OrderedMap {
+	schema := newSchema()
+	switch op {
+	case hclsyntax.OpEqual:
+		schema.Set("const", value)
+	case hclsyntax.OpNotEqual:
+		not := newSchema()
+		not.Set("const", value)
+		schema.Set("not", not)
+	default:
+		if _, ok := value.(jsonsdk.Number); !ok {
+			return nil
+		}
+		keyword := map[*hclsyntax.Operation]string{
+			hclsyntax.OpGreaterThan:        "exclusiveMinimum",
+			hclsyntax.OpGreaterThanOrEqual: "minimum",
+			hclsyntax.OpLessThan:           "exclusiveMaximum",
+			hclsyntax.OpLessThanOrEqual:    "maximum",
+		}[op]
+		if keyword == "" {
+			return nil
+		}
+		schema.Set(keyword, value)
+	}
+	return schema
+}
+
+// lengthSchema returns the schema of the length of the input compared with
+// 'value', based on its type, e.g. 'minLength' for strings and 'minItems' for
+// lists.
+func lengthSchema(op *hclsyntax.Operation, value interface{}, t *terraform.TypeSchema) *orderedmap.OrderedMap {
+	number, ok := value.(jsonsdk.Number)
+	if !ok || t == nil {
+		return nil
+	}
+	n, err := number.Int64()
+	if err != nil {
+		return nil
+	}
+
+	var suffix string
+	switch t.Kind {
+	case terraform.TypeString:
+		suffix = "Length"
+	case terraform.TypeList, terraform.TypeSet, terraform.TypeTuple:
+		suffix = "Items"
+	case terraform.TypeMap, terraform.TypeObject:
+		suffix = "Properties"
+	default:
+		return nil
+	}
+
+	schema := newSchema()
+	switch op {
+	case hclsyntax.OpEqual:
+		schema.Set("min"+suffix, n)
+		schema.Set("max"+suffix, n)
+	case hclsyntax.OpGreaterThan:
+		schema.Set("min"+suffix, n+1)
+	case hclsyntax.OpGreaterThanOrEqual:
+		schema.Set("min"+suffix, n)
+	case hclsyntax.OpLessThan:
+		schema.Set("max"+suffix, max(n-1, 0))
+	case hclsyntax.OpLessThanOrEqual:
+		schema.Set("max"+suffix, n)
+	default:
+		return nil
+	}
+	return schema
+}
+
+func functionCallSchema(e *hclsyntax.FunctionCallExpr, i *terraform.Input) *orderedmap.OrderedMap {
+	switch {
+	// can(regex("pattern", var.x))
+	case e.Name == "can" && len(e.Args) == 1:
+		if pattern := regexPattern(e.Args[0], "regex", i); pattern != "" {
+			schema := newSchema()
+			schema.Set("pattern", pattern)
+			return schema
+		}
+	// contains(["a", "b"], var.x)
+	case e.Name == "contains" && len(e.Args) == 2 && isInputReference(e.Args[1], i):
+		if values, ok := literalValue(e.Args[0]); ok {
+			if enum, ok := values.([]interface{}); ok {
+				schema := newSchema()
+				schema.Set("enum", enum)
+				return schema
+			}
+		}
+	}
+	return nil
+}
+
+// regexPattern returns the pattern of 'expr' if it's a call of function
+// 'name' (i.e. regex or regexall) with a literal pattern on the input.
+func regexPattern(expr hclsyntax.Expression, name string, i *terraform.Input) string {
+	call, ok := expr.(*hclsyntax.FunctionCallExpr)
+	if !ok || call.Name != name || len(call.Args) != 2 || !isInputReference(call.Args[1], i) {
+		return ""
+	}
+	value, ok := literalValue(call.Args[0])
+	if !ok {
+		return ""
+	}
+	pattern, _ := value.(string)
+	return pattern
+}
+
+// isInputReference returns whether 'expr' is a reference to input 'i', i.e.
+// 'var.<name>'.
+func isInputReference(expr hclsyntax.Expression, i *terraform.Input) bool {
+	traversal, ok := expr.(*hclsyntax.ScopeTraversalExpr)
+	if !ok || len(traversal.Traversal) != 2 || traversal.Traversal.RootName() != "var" {
+		return false
+	}
+	attr, ok := traversal.Traversal[1].(hcl.TraverseAttr)
+	return ok && attr.Name == i.Name
+}
+
+// literalValue returns the value of 'expr' if it's a literal (i.e. it doesn't
+// reference anything), decoded from JSON with numbers as json.Number.
+func literalValue(expr hclsyntax.Expression) (interface{}, bool) {
+	if len(expr.Variables()) > 0 {
+		return nil, false
+	}
+	value, diags := expr.Value(nil)
+	if diags.HasErrors() || !value.IsWhollyKnown() {
+		return nil, false
+	}
+	raw, err := ctyjson.SimpleJSONValue{Value: value}.MarshalJSON()
+	if err != nil {
+		return nil, false
+	}
+	decoder := jsonsdk.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, false
+	}
+	return v, true
+}
+
+func init() {
+	register(map[string]initializerFn{
+		"json-schema": NewJSONSchema,
+	})
+}
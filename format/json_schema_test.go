@@ -0,0 +1,217 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	jsonsdk "encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+func TestJSONSchema(t *testing.T) {
+	tests := map[string]struct {
+		config print.Config
+	}{
+		// Base
+		"Base": {
+			config: testutil.WithSections(),
+		},
+		"Empty": {
+			config: testutil.WithDefaultSections(
+				testutil.With(func(c *print.Config) {
+					c.ModuleRoot = "empty"
+				}),
+			),
+		},
+
+		// Settings
+		"EscapeCharacters": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Settings.Escape = true
+				}),
+			),
+		},
+
+		"WithValidation": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "validations"
+				c.Sections.Inputs = true
+			}),
+		},
+		"WithObjectAttributes": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "object-attributes"
+				c.Sections.Inputs = true
+			}),
+		},
+		"WithTypeSchema": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "types"
+				c.Sections.Inputs = true
+			}),
+		},
+		"WithExampleValues": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "example-values"
+				c.Sections.Inputs = true
+				c.ExampleValues.Enabled = true
+			}),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			expected, err := testutil.GetExpected("json", "schema-"+name)
+			assert.Nil(err)
+
+			module, err := testutil.GetModule(&tt.config)
+			assert.Nil(err)
+
+			formatter := NewJSONSchema(&tt.config)
+
+			err = formatter.Generate(module)
+			assert.Nil(err)
+
+			assert.Equal(expected, formatter.Content())
+		})
+	}
+}
+
+func TestJSONSchemaValidation(t *testing.T) {
+	tests := map[string]struct {
+		condition string
+		kind      string
+		expected  string
+	}{
+		"Equal": {
+			condition: `var.foo == "bar"`,
+			kind:      terraform.TypeString,
+			expected:  `{"const":"bar"}`,
+		},
+		"NotEqual": {
+			condition: `var.foo != "bar"`,
+			kind:      terraform.TypeString,
+			expected:  `{"not":{"const":"bar"}}`,
+		},
+		"Range": {
+			condition: `var.foo >= 1 && var.foo < 10`,
+			kind:      terraform.TypeNumber,
+			expected:  `{"minimum":1,"exclusiveMaximum":10}`,
+		},
+		"Flipped": {
+			condition: `0 < var.foo`,
+			kind:      terraform.TypeNumber,
+			expected:  `{"exclusiveMinimum":0}`,
+		},
+		"Either": {
+			condition: `var.foo == 0 || var.foo > 2`,
+			kind:      terraform.TypeNumber,
+			expected:  `{"anyOf":[{"const":0},{"exclusiveMinimum":2}]}`,
+		},
+		"StringLength": {
+			condition: `length(var.foo) > 3`,
+			kind:      terraform.TypeString,
+			expected:  `{"minLength":4}`,
+		},
+		"ListLength": {
+			condition: `(length(var.foo) <= 5)`,
+			kind:      terraform.TypeList,
+			expected:  `{"maxItems":5}`,
+		},
+		"MapLength": {
+			condition: `length(var.foo) == 2`,
+			kind:      terraform.TypeMap,
+			expected:  `{"minProperties":2,"maxProperties":2}`,
+		},
+		"Regex": {
+			condition: `can(regex("^[a-z]+$", var.foo))`,
+			kind:      terraform.TypeString,
+			expected:  `{"pattern":"^[a-z]+$"}`,
+		},
+		"RegexAll": {
+			condition: `length(regexall("^[a-z]+$", var.foo)) > 0`,
+			kind:      terraform.TypeString,
+			expected:  `{"pattern":"^[a-z]+$"}`,
+		},
+		"Contains": {
+			condition: `contains(["a", "b"], var.foo)`,
+			kind:      terraform.TypeString,
+			expected:  `{"enum":["a","b"]}`,
+		},
+		"Not": {
+			condition: `!contains(["a", "b"], var.foo)`,
+			kind:      terraform.TypeString,
+			expected:  `{"not":{"enum":["a","b"]}}`,
+		},
+		"Conflicting": {
+			condition: `var.foo > 1 && var.foo > 2`,
+			kind:      terraform.TypeNumber,
+			expected:  `{"allOf":[{"exclusiveMinimum":1},{"exclusiveMinimum":2}]}`,
+		},
+		"PartiallySupported": {
+			condition: `var.foo > 1 && var.foo != var.bar`,
+			kind:      terraform.TypeNumber,
+			expected:  `{"exclusiveMinimum":1}`,
+		},
+		"NegatedPartiallySupported": {
+			condition: `!(var.foo > 1 && var.foo != var.bar)`,
+			kind:      terraform.TypeNumber,
+			expected:  `null`,
+		},
+		"DoubleNegatedPartiallySupported": {
+			condition: `!!(var.foo > 1 && var.foo != var.bar)`,
+			kind:      terraform.TypeNumber,
+			expected:  `{"not":{"not":{"exclusiveMinimum":1}}}`,
+		},
+		"NegatedSupported": {
+			condition: `!(var.foo > 1 && var.foo < 5)`,
+			kind:      terraform.TypeNumber,
+			expected:  `{"not":{"exclusiveMinimum":1,"exclusiveMaximum":5}}`,
+		},
+		"Unsupported": {
+			condition: `var.foo == 0 || var.foo == var.bar`,
+			kind:      terraform.TypeNumber,
+			expected:  `null`,
+		},
+		"OtherVariable": {
+			condition: `var.bar > 0`,
+			kind:      terraform.TypeNumber,
+			expected:  `null`,
+		},
+		"Invalid": {
+			condition: `var.foo >`,
+			kind:      terraform.TypeNumber,
+			expected:  `null`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			input := &terraform.Input{
+				Name:       "foo",
+				TypeSchema: &terraform.TypeSchema{Kind: tt.kind},
+			}
+			schema := validationSchema(&terraform.Validation{Condition: tt.condition}, input)
+
+			actual, err := jsonsdk.Marshal(schema)
+			assert.Nil(err)
+			assert.Equal(tt.expected, string(actual))
+		})
+	}
+}
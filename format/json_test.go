@@ -58,10 +58,51 @@ func TestJson(t *testing.T) {
 			}),
 		},
 
+		"WithValidation": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "validations"
+				c.Sections.Inputs = true
+			}),
+		},
+
+		"WithInputAttributes": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "attributes"
+				c.Sections.Inputs = true
+			}),
+		},
+		"WithNestedResources": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "nested-resources"
+				c.Sections.DataSources = true
+				c.Sections.ModuleCalls = true
+				c.Sections.Resources = true
+				c.Settings.NestedResources = true
+			}),
+		},
+		"WithObjectAttributes": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "object-attributes"
+				c.Sections.Inputs = true
+			}),
+		},
+		"WithTypeSchema": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "types"
+				c.Sections.Inputs = true
+			}),
+		},
+
 		// Only section
 		"OnlyDataSources": {
 			config: testutil.With(func(c *print.Config) { c.Sections.DataSources = true }),
 		},
+		"OnlyChecks": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "checks"
+				c.Sections.Checks = true
+			}),
+		},
 		"OnlyHeader": {
 			config: testutil.With(func(c *print.Config) { c.Sections.Header = true }),
 		},
@@ -74,6 +115,12 @@ func TestJson(t *testing.T) {
 		"OnlyInputs": {
 			config: testutil.With(func(c *print.Config) { c.Sections.Inputs = true }),
 		},
+		"OnlyMigrations": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "migrations"
+				c.Sections.Migrations = true
+			}),
+		},
 		"OnlyOutputs": {
 			config: testutil.With(func(c *print.Config) { c.Sections.Outputs = true }),
 		},
@@ -83,6 +130,20 @@ func TestJson(t *testing.T) {
 		"OnlyProviders": {
 			config: testutil.With(func(c *print.Config) { c.Sections.Providers = true }),
 		},
+		"WithLockFile": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "lock-file"
+				c.Sections.Providers = true
+				c.Settings.LockFile = true
+			}),
+		},
+		"WithRequirements": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "requirements"
+				c.Sections.Requirements = true
+				c.Settings.Source = true
+			}),
+		},
 		"OnlyRequirements": {
 			config: testutil.With(func(c *print.Config) { c.Sections.Requirements = true }),
 		},
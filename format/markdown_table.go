@@ -12,6 +12,7 @@ package format
 
 import (
 	"embed"
+	"strings"
 	gotemplate "text/template"
 
 	"github.com/terraform-docs/terraform-docs/print"
@@ -47,6 +48,19 @@ func NewMarkdownTable(config *print.Config) Type {
 			}
 			return result
 		},
+		"validations": func(vv []*terraform.Validation) string {
+			items := make([]string, 0, len(vv))
+			for _, v := range vv {
+				items = append(items, printValidation(v, true))
+			}
+			return strings.Join(items, "\n")
+		},
+		"check": func(c *terraform.Check) string {
+			return printCheck(c, true)
+		},
+		"resourceTree": func(m *terraform.Module) string {
+			return printMarkdownResourceTree(m, config)
+		},
 	})
 
 	return &markdownTable{
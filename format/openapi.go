@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"bytes"
+	jsonsdk "encoding/json"
+	"strings"
+
+	"github.com/iancoleman/orderedmap"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+const (
+	// openAPIVersion is the version of OpenAPI Specification of the generated
+	// document, which uses JSON Schema draft 2020-12 for its schemas.
+	openAPIVersion = "3.1.0"
+
+	// openAPIDefaultVersion is the version of the module in the generated
+	// document if 'usage.version' is not set.
+	openAPIDefaultVersion = "0.0.0"
+)
+
+// openAPI represents OpenAPI format, i.e. a document with the inputs and the
+// outputs of the module as component schemas.
+type openAPI struct {
+	*generator
+
+	config *print.Config
+}
+
+// NewOpenAPI returns new instance of OpenAPI.
+func NewOpenAPI(config *print.Config) Type {
+	return &openAPI{
+		generator: newGenerator(config, false),
+		config:    config,
+	}
+}
+
+// Generate a Terraform module as OpenAPI document.
+func (o *openAPI) Generate(module *terraform.Module) error {
+	info := newSchema()
+	info.Set("title", moduleDirName(o.config))
+	if o.config.Sections.Header && module.Header != "" {
+		info.Set("description", module.Header)
+	}
+	info.Set("version", openAPIDefaultVersion)
+	if o.config.Usage.Version != "" {
+		info.Set("version", o.config.Usage.Version)
+	}
+
+	schemas := newSchema()
+	if o.config.Sections.Inputs {
+		schemas.Set("Inputs", openAPIInputsSchema(module.Inputs))
+	}
+	if o.config.Sections.Outputs {
+		schemas.Set("Outputs", openAPIOutputsSchema(module.Outputs))
+	}
+
+	components := newSchema()
+	components.Set("schemas", schemas)
+
+	document := newSchema()
+	document.Set("openapi", openAPIVersion)
+	document.Set("info", info)
+	document.Set("components", components)
+
+	buffer := new(bytes.Buffer)
+	encoder := jsonsdk.NewEncoder(buffer)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(o.config.Settings.Escape)
+
+	if err := encoder.Encode(document); err != nil {
+		return err
+	}
+
+	o.funcs(withContent(strings.TrimSuffix(buffer.String(), "\n")))
+
+	return nil
+}
+
+// openAPIInputsSchema returns the schema of the inputs, same as the one of
+// 'json-schema' format, where the sensitive ones are marked with 'x-sensitive'
+// extension.
+func openAPIInputsSchema(inputs []*terraform.Input) *orderedmap.OrderedMap {
+	schema := inputsSchema(inputs)
+	value, _ := schema.Get("properties")
+	properties := value.(*orderedmap.OrderedMap)
+	for _, i := range inputs {
+		if !i.Sensitive {
+			continue
+		}
+		value, _ := properties.Get(i.Name)
+		value.(*orderedmap.OrderedMap).Set("x-sensitive", true)
+	}
+	return schema
+}
+
+// openAPIOutputsSchema returns the schema of an object of all the 'outputs'.
+// Outputs don't have a type constraint, so their value is shown as example if
+// it's available (i.e. '--output-values' is set).
+func openAPIOutputsSchema(outputs []*terraform.Output) *orderedmap.OrderedMap {
+	properties := newSchema()
+	required := []string{}
+	for _, o := range outputs {
+		output := newSchema()
+		if o.Description != "" {
+			output.Set("description", string(o.Description))
+		}
+		output.Set("readOnly", true)
+		if o.GetValue() != "" {
+			output.Set("examples", []interface{}{o.Value})
+		}
+		if o.Sensitive {
+			output.Set("x-sensitive", true)
+		}
+		properties.Set(o.Name, output)
+		required = append(required, o.Name)
+	}
+
+	schema := newSchema()
+	schema.Set("type", "object")
+	schema.Set("properties", properties)
+	if len(required) > 0 {
+		schema.Set("required", required)
+	}
+	return schema
+}
+
+func init() {
+	register(map[string]initializerFn{
+		"openapi": NewOpenAPI,
+	})
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+func TestOpenAPI(t *testing.T) {
+	tests := map[string]struct {
+		config print.Config
+	}{
+		// Base
+		"Base": {
+			config: testutil.WithSections(),
+		},
+		"Empty": {
+			config: testutil.WithDefaultSections(
+				testutil.With(func(c *print.Config) {
+					c.ModuleRoot = "empty"
+				}),
+			),
+		},
+
+		// Settings
+		"EscapeCharacters": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Settings.Escape = true
+				}),
+			),
+		},
+
+		"OutputValues": {
+			config: testutil.With(func(c *print.Config) {
+				c.Sections.Outputs = true
+				c.OutputValues.Enabled = true
+				c.OutputValues.From = "output_values.json"
+				c.Settings.Sensitive = true
+			}),
+		},
+		"WithVersion": {
+			config: testutil.WithSections(
+				testutil.With(func(c *print.Config) {
+					c.Usage.Version = "1.2.0"
+				}),
+			),
+		},
+
+		"WithValidation": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "validations"
+				c.Sections.Inputs = true
+			}),
+		},
+		"WithObjectAttributes": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "object-attributes"
+				c.Sections.Inputs = true
+			}),
+		},
+		"WithTypeSchema": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "types"
+				c.Sections.Inputs = true
+			}),
+		},
+		"WithExampleValues": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "example-values"
+				c.Sections.Inputs = true
+				c.ExampleValues.Enabled = true
+			}),
+		},
+
+		// Only section
+		"OnlyHeader": {
+			config: testutil.With(func(c *print.Config) { c.Sections.Header = true }),
+		},
+		"OnlyInputs": {
+			config: testutil.With(func(c *print.Config) { c.Sections.Inputs = true }),
+		},
+		"OnlyOutputs": {
+			config: testutil.With(func(c *print.Config) { c.Sections.Outputs = true }),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			expected, err := testutil.GetExpected("json", "openapi-"+name)
+			assert.Nil(err)
+
+			module, err := testutil.GetModule(&tt.config)
+			assert.Nil(err)
+
+			formatter := NewOpenAPI(&tt.config)
+
+			err = formatter.Generate(module)
+			assert.Nil(err)
+
+			assert.Equal(expected, formatter.Content())
+		})
+	}
+}
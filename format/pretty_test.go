@@ -62,6 +62,12 @@ func TestPretty(t *testing.T) {
 		"OnlyDataSources": {
 			config: testutil.With(func(c *print.Config) { c.Sections.DataSources = true }),
 		},
+		"OnlyChecks": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "checks"
+				c.Sections.Checks = true
+			}),
+		},
 		"OnlyHeader": {
 			config: testutil.With(func(c *print.Config) { c.Sections.Header = true }),
 		},
@@ -74,6 +80,12 @@ func TestPretty(t *testing.T) {
 		"OnlyInputs": {
 			config: testutil.With(func(c *print.Config) { c.Sections.Inputs = true }),
 		},
+		"OnlyMigrations": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "migrations"
+				c.Sections.Migrations = true
+			}),
+		},
 		"OnlyOutputs": {
 			config: testutil.With(func(c *print.Config) { c.Sections.Outputs = true }),
 		},
@@ -83,6 +95,20 @@ func TestPretty(t *testing.T) {
 		"OnlyProviders": {
 			config: testutil.With(func(c *print.Config) { c.Sections.Providers = true }),
 		},
+		"WithLockFile": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "lock-file"
+				c.Sections.Providers = true
+				c.Settings.LockFile = true
+			}),
+		},
+		"WithRequirements": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "requirements"
+				c.Sections.Requirements = true
+				c.Settings.Source = true
+			}),
+		},
 		"OnlyRequirements": {
 			config: testutil.With(func(c *print.Config) { c.Sections.Requirements = true }),
 		},
@@ -0,0 +1,128 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+	gotemplate "text/template"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/template"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+//go:embed templates/rst_document*.tmpl
+var rstDocumentFS embed.FS
+
+// rstDocument represents reStructuredText Document format.
+type rstDocument struct {
+	*generator
+
+	config   *print.Config
+	template *template.Template
+}
+
+// NewRSTDocument returns new instance of reStructuredText Document.
+func NewRSTDocument(config *print.Config) Type {
+	items := readTemplateItems(rstDocumentFS, "rst_document")
+
+	tt := template.New(config, items...)
+	tt.CustomFunc(gotemplate.FuncMap{
+		"heading": func(extra int, title string) string {
+			return template.GenerateRSTHeading(config.Settings.Indent, extra, title)
+		},
+		"section": func(extra int, prefix string, value string) string {
+			return printRSTTitle(extra, prefix, value, config)
+		},
+		"item": printRSTListItem,
+		"type": func(t string) string {
+			result, extraline := PrintRSTCodeBlock(t, "hcl")
+			if !extraline {
+				result += "\n"
+			}
+			return result
+		},
+		"value": func(v string) string {
+			if v == "n/a" {
+				return v
+			}
+			result, extraline := PrintRSTCodeBlock(v, "json")
+			if !extraline {
+				result += "\n"
+			}
+			return result
+		},
+		"isRequired": func() bool {
+			return config.Settings.Required
+		},
+		"validation": func(v *terraform.Validation) string {
+			return printValidation(v, false)
+		},
+		"check": func(c *terraform.Check) string {
+			return printCheck(c, false)
+		},
+		"attribute": func(a *terraform.NestedAttribute) string {
+			return printAttribute(a, config)
+		},
+	})
+
+	return &rstDocument{
+		generator: newGenerator(config, true),
+		config:    config,
+		template:  tt,
+	}
+}
+
+// Generate a Terraform module as reStructuredText document.
+func (d *rstDocument) Generate(module *terraform.Module) error {
+	err := d.forEach(func(name string) (string, error) {
+		rendered, err := d.template.Render(name, module)
+		if err != nil {
+			return "", err
+		}
+		return sanitize(rendered), nil
+	})
+
+	d.funcs(withModule(module))
+
+	return err
+}
+
+// printRSTTitle prints a section titled 'value', preceded by its label (i.e.
+// 'prefix_value') to be referenced with ':ref:' if anchors are enabled.
+func printRSTTitle(extra int, prefix string, value string, config *print.Config) string {
+	heading := template.GenerateRSTHeading(config.Settings.Indent, extra, template.EscapeRSTCharacters(value, config.Settings.Escape))
+	if !config.Settings.Anchor {
+		return heading
+	}
+	return fmt.Sprintf(".. _%s_%s:\n\n%s", prefix, value, heading)
+}
+
+// printRSTListItem prints 's' as an item of a bullet list, with its following
+// lines indented to be part of the item.
+func printRSTListItem(s string) string {
+	lines := strings.Split(s, "\n")
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) != "" {
+			lines[i] = "  " + lines[i]
+		}
+	}
+	return "- " + strings.Join(lines, "\n")
+}
+
+func init() {
+	register(map[string]initializerFn{
+		"rst document": NewRSTDocument,
+		"rst doc":      NewRSTDocument,
+	})
+}
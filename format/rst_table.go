@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"embed"
+	"strings"
+	gotemplate "text/template"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/template"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+//go:embed templates/rst_table*.tmpl
+var rstTableFS embed.FS
+
+// rstTable represents reStructuredText Table format.
+type rstTable struct {
+	*generator
+
+	config   *print.Config
+	template *template.Template
+}
+
+// NewRSTTable returns new instance of reStructuredText Table.
+func NewRSTTable(config *print.Config) Type {
+	items := readTemplateItems(rstTableFS, "rst_table")
+
+	tt := template.New(config, items...)
+	tt.CustomFunc(gotemplate.FuncMap{
+		"heading": func(extra int, title string) string {
+			return template.GenerateRSTHeading(config.Settings.Indent, extra, title)
+		},
+		"type": func(t string) string {
+			inputType, _ := PrintRSTCodeBlock(t, "hcl")
+			return inputType
+		},
+		"value": func(v string) string {
+			var result = "n/a"
+			if v != "" {
+				result, _ = PrintRSTCodeBlock(v, "json")
+			}
+			return result
+		},
+		"validations": func(vv []*terraform.Validation) string {
+			if len(vv) == 1 {
+				return template.SanitizeRST(printValidation(vv[0], false), config.Settings.Escape)
+			}
+			items := make([]string, 0, len(vv))
+			for _, v := range vv {
+				items = append(items, "- "+template.SanitizeRST(printValidation(v, false), config.Settings.Escape))
+			}
+			return strings.Join(items, "\n")
+		},
+		"check": func(c *terraform.Check) string {
+			return template.SanitizeRST(printCheck(c, false), config.Settings.Escape)
+		},
+	})
+
+	return &rstTable{
+		generator: newGenerator(config, true),
+		config:    config,
+		template:  tt,
+	}
+}
+
+// Generate a Terraform module as reStructuredText tables.
+func (t *rstTable) Generate(module *terraform.Module) error {
+	err := t.forEach(func(name string) (string, error) {
+		rendered, err := t.template.Render(name, module)
+		if err != nil {
+			return "", err
+		}
+		return sanitize(rendered), nil
+	})
+
+	t.funcs(withModule(module))
+
+	return err
+}
+
+func init() {
+	register(map[string]initializerFn{
+		"rst":       NewRSTTable,
+		"rst table": NewRSTTable,
+		"rst tbl":   NewRSTTable,
+	})
+}
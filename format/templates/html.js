@@ -0,0 +1,24 @@
+document.querySelectorAll("table.sortable th").forEach(function (th) {
+  th.addEventListener("click", function () {
+    var table = th.closest("table");
+    var tbody = table.tBodies[0];
+    var index = Array.prototype.indexOf.call(th.parentNode.children, th);
+    var ascending = th.getAttribute("aria-sort") !== "ascending";
+
+    Array.prototype.forEach.call(th.parentNode.children, function (cell) {
+      cell.removeAttribute("aria-sort");
+    });
+    th.setAttribute("aria-sort", ascending ? "ascending" : "descending");
+
+    var rows = Array.prototype.slice.call(tbody.rows);
+    rows.sort(function (a, b) {
+      var x = a.cells[index].textContent.trim();
+      var y = b.cells[index].textContent.trim();
+      var result = x.localeCompare(y, undefined, { numeric: true });
+      return ascending ? result : -result;
+    });
+    rows.forEach(function (row) {
+      tbody.appendChild(row);
+    });
+  });
+});
@@ -31,12 +31,18 @@ type tfvarsHCL struct {
 
 	config   *print.Config
 	template *template.Template
-}
 
-var padding []int
+	// padding of name of each input of the module being generated.
+	padding []int
+}
 
 // NewTfvarsHCL returns new instance of TfvarsHCL.
 func NewTfvarsHCL(config *print.Config) Type {
+	h := &tfvarsHCL{
+		generator: newGenerator(config, false),
+		config:    config,
+	}
+
 	tt := template.New(config, &template.Item{
 		Name:      "tfvars",
 		Text:      string(tfvarsHCLTpl),
@@ -44,7 +50,7 @@ func NewTfvarsHCL(config *print.Config) Type {
 	})
 	tt.CustomFunc(gotemplate.FuncMap{
 		"align": func(s string, i int) string {
-			return fmt.Sprintf("%-*s", padding[i], s)
+			return fmt.Sprintf("%-*s", h.padding[i], s)
 		},
 		"value": func(s string) string {
 			if s == "" {
@@ -58,18 +64,22 @@ func NewTfvarsHCL(config *print.Config) Type {
 		"showDescription": func() bool {
 			return config.Settings.Description
 		},
+		"tfvarsValue": func(input *terraform.Input) string {
+			if input.HasExample() {
+				return input.GetExample()
+			}
+			return input.GetValue()
+		},
 	})
 
-	return &tfvarsHCL{
-		generator: newGenerator(config, false),
-		config:    config,
-		template:  tt,
-	}
+	h.template = tt
+
+	return h
 }
 
 // Generate a Terraform module as Terraform tfvars HCL.
 func (h *tfvarsHCL) Generate(module *terraform.Module) error {
-	alignments(module.Inputs, h.config)
+	h.padding = alignments(module.Inputs, h.config)
 
 	rendered, err := h.template.Render("tfvars", module)
 	if err != nil {
@@ -82,13 +92,26 @@ func (h *tfvarsHCL) Generate(module *terraform.Module) error {
 }
 
 func isMultilineFormat(input *terraform.Input) bool {
-	isList := input.Type == "list" || reflect.TypeOf(input.Default).Name() == "List"
-	isMap := input.Type == "map" || reflect.TypeOf(input.Default).Name() == "Map"
-	return (isList || isMap) && input.Default.Length() > 0
+	value := tfvarsValue(input)
+	isList := input.Type == "list" || reflect.TypeOf(value).Name() == "List"
+	isMap := input.Type == "map" || reflect.TypeOf(value).Name() == "Map"
+	return (isList || isMap) && value.Length() > 0
+}
+
+// tfvarsValue returns the example value of 'input' if it has any, otherwise
+// its default value.
+func tfvarsValue(input *terraform.Input) types.Value {
+	if input.HasExample() {
+		return input.Example
+	}
+	return input.Default
 }
 
-func alignments(inputs []*terraform.Input, config *print.Config) {
-	padding = make([]int, len(inputs))
+// alignments returns the padding of name of each of 'inputs' to align their
+// values. Inputs are aligned in groups, which are separated by multiline or
+// described (if enabled) inputs.
+func alignments(inputs []*terraform.Input, config *print.Config) []int {
+	padding := make([]int, len(inputs))
 	maxlen := 0
 	index := 0
 	for i, input := range inputs {
@@ -108,6 +131,7 @@ func alignments(inputs []*terraform.Input, config *print.Config) {
 	for i := index; i < len(inputs); i++ {
 		padding[i] = maxlen
 	}
+	return padding
 }
 
 func init() {
@@ -11,6 +11,7 @@ the root directory of this source tree.
 package format
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -48,6 +49,13 @@ func TestTfvarsHcl(t *testing.T) {
 				}),
 			),
 		},
+		"WithExampleValues": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "example-values"
+				c.Sections.Inputs = true
+				c.ExampleValues.Enabled = true
+			}),
+		},
 		"SortByName": {
 			config: testutil.WithSections(
 				testutil.With(func(c *print.Config) {
@@ -101,3 +109,36 @@ func TestTfvarsHcl(t *testing.T) {
 		})
 	}
 }
+
+func TestTfvarsHclConcurrent(t *testing.T) {
+	tests := map[string]print.Config{
+		"Base": testutil.WithSections(),
+		"WithExampleValues": testutil.With(func(c *print.Config) {
+			c.ModuleRoot = "example-values"
+			c.Sections.Inputs = true
+			c.ExampleValues.Enabled = true
+		}),
+	}
+
+	var wg sync.WaitGroup
+	for name, config := range tests {
+		expected, err := testutil.GetExpected("tfvars", "hcl-"+name)
+		assert.Nil(t, err)
+
+		module, err := testutil.GetModule(&config)
+		assert.Nil(t, err)
+
+		for range 4 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				formatter := NewTfvarsHCL(&config)
+				err := formatter.Generate(module)
+				assert.Nil(t, err)
+				assert.Equal(t, expected, formatter.Content())
+			}()
+		}
+	}
+	wg.Wait()
+}
@@ -41,7 +41,7 @@ func (j *tfvarsJSON) Generate(module *terraform.Module) error {
 	copy := orderedmap.New()
 	copy.SetEscapeHTML(false)
 	for _, i := range module.Inputs {
-		copy.Set(i.Name, i.Default)
+		copy.Set(i.Name, tfvarsValue(i))
 	}
 
 	buffer := new(bytes.Buffer)
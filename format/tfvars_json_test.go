@@ -39,6 +39,13 @@ func TestTfvarsJson(t *testing.T) {
 				c.Settings.Escape = true
 			}),
 		},
+		"WithExampleValues": {
+			config: testutil.With(func(c *print.Config) {
+				c.ModuleRoot = "example-values"
+				c.Sections.Inputs = true
+				c.ExampleValues.Enabled = true
+			}),
+		},
 		"SortByName": {
 			config: testutil.WithSections(
 				testutil.With(func(c *print.Config) {
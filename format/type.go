@@ -25,7 +25,9 @@ type Type interface {
 
 	Header() string       // header section based on the underlying format
 	Footer() string       // footer section based on the underlying format
+	Checks() string       // checks section based on the underlying format
 	Inputs() string       // inputs section based on the underlying format
+	Migrations() string   // migrations section based on the underlying format
 	Modules() string      // modules section based on the underlying format
 	Outputs() string      // outputs section based on the underlying format
 	Providers() string    // providers section based on the underlying format
@@ -70,6 +70,39 @@ func PrintFencedAsciidocCodeBlock(code string, language string) (string, bool) {
 	return fmt.Sprintf("`%s`", code), false
 }
 
+// PrintRSTCodeBlock prints codes in reStructuredText, it automatically detects
+// if the input 'code' contains '\n' it will use 'code-block' directive,
+// otherwise it wraps the 'code' inside inline literal.
+// If the code is multi-line it also appends an extra '\n` at the end and
+// returns true accordingly, otherwise returns false for non-carriage return.
+func PrintRSTCodeBlock(code string, language string) (string, bool) {
+	if strings.Contains(code, "\n") {
+		return fmt.Sprintf("\n\n%s\n", template.ConvertRSTCodeBlock(code, language)), true
+	}
+	return fmt.Sprintf("``%s``", code), false
+}
+
+// printValidation prints a validation rule of an input as its condition,
+// collapsed into a single line and wrapped in code, followed by its error
+// message. If 'escapePipe' is true the pipe characters of the condition are
+// escaped to be safely used inside a table.
+func printValidation(validation *terraform.Validation, escapePipe bool) string {
+	condition := strings.Join(strings.Fields(validation.Condition), " ")
+	if escapePipe {
+		condition = strings.ReplaceAll(condition, "|", "\\|")
+	}
+	return fmt.Sprintf("`%s`: %s", condition, strings.TrimSpace(string(validation.ErrorMessage)))
+}
+
+// printCheck returns the representation of a check, the same way as a
+// validation rule of an input is represented (see printValidation).
+func printCheck(check *terraform.Check, escapePipe bool) string {
+	return printValidation(&terraform.Validation{
+		Condition:    check.Condition,
+		ErrorMessage: check.ErrorMessage,
+	}, escapePipe)
+}
+
 // readTemplateItems reads all static formatter .tmpl files prefixed by specific string
 // from an embed file system.
 func readTemplateItems(efs embed.FS, prefix string) []*template.Item {
@@ -108,7 +141,9 @@ func copySections(config *print.Config, src *terraform.Module) *terraform.Module
 	dest := &terraform.Module{
 		Header:       "",
 		Footer:       "",
+		Checks:       make([]*terraform.Check, 0),
 		Inputs:       make([]*terraform.Input, 0),
+		Migrations:   make([]*terraform.Migration, 0),
 		ModuleCalls:  make([]*terraform.ModuleCall, 0),
 		Outputs:      make([]*terraform.Output, 0),
 		Providers:    make([]*terraform.Provider, 0),
@@ -122,9 +157,15 @@ func copySections(config *print.Config, src *terraform.Module) *terraform.Module
 	if config.Sections.Footer {
 		dest.Footer = src.Footer
 	}
+	if config.Sections.Checks {
+		dest.Checks = src.Checks
+	}
 	if config.Sections.Inputs {
 		dest.Inputs = src.Inputs
 	}
+	if config.Sections.Migrations {
+		dest.Migrations = src.Migrations
+	}
 	if config.Sections.ModuleCalls {
 		dest.ModuleCalls = src.ModuleCalls
 	}
@@ -157,3 +198,69 @@ func filterResourcesByMode(config *print.Config, module []*terraform.Resource) [
 	}
 	return resources
 }
+
+// printAttribute prints a nested attribute of an object input as its path,
+// wrapped in code, followed by its type, whether it's required or not and its
+// default value, based on the given settings.
+func printAttribute(attribute *terraform.NestedAttribute, config *print.Config) string {
+	details := make([]string, 0, 2)
+	if config.Settings.Type {
+		details = append(details, fmt.Sprintf("`%s`", attribute.Type))
+	}
+	if config.Settings.Required && !attribute.Optional {
+		details = append(details, "required")
+	}
+	if config.Settings.Default && attribute.Optional {
+		details = append(details, fmt.Sprintf("default: `%s`", attribute.GetValue()))
+	}
+	if len(details) == 0 {
+		return fmt.Sprintf("`%s`", attribute.Path)
+	}
+	return fmt.Sprintf("`%s` (%s)", attribute.Path, strings.Join(details, ", "))
+}
+
+// printMarkdownResourceTree prints the resources of the module and of its
+// local submodules, recursively, as a nested list of their addresses. The
+// submodules without any visible resources are left out.
+func printMarkdownResourceTree(module *terraform.Module, config *print.Config) string {
+	var b strings.Builder
+	writeMarkdownResourceTree(&b, module.Resources, module.ModuleCalls, config, 0)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func writeMarkdownResourceTree(b *strings.Builder, resources []*terraform.Resource, modulecalls []*terraform.ModuleCall, config *print.Config, depth int) bool {
+	indent := strings.Repeat("  ", depth)
+	written := false
+
+	for _, r := range template.VisibleResources(resources, config) {
+		spec := fmt.Sprintf("`%s`", r.Address)
+		if url := r.URL(); url != "" {
+			spec = fmt.Sprintf("[%s](%s)", spec, url)
+		}
+		details := []string{r.GetMode()}
+		if r.MetaArgument != "" {
+			details = append(details, fmt.Sprintf("`%s`", r.MetaArgument))
+		}
+		fmt.Fprintf(b, "%s- %s (%s)\n", indent, spec, strings.Join(details, ", "))
+		written = true
+	}
+
+	for _, m := range modulecalls {
+		if m.Address == "" {
+			continue // not a local module
+		}
+		var nested strings.Builder
+		if !writeMarkdownResourceTree(&nested, m.Resources, m.ModuleCalls, config, depth+1) {
+			continue
+		}
+		details := []string{fmt.Sprintf("`%s`", m.Source)}
+		if m.MetaArgument != "" {
+			details = append(details, fmt.Sprintf("`%s`", m.MetaArgument))
+		}
+		fmt.Fprintf(b, "%s- `%s` (%s)\n", indent, m.Address, strings.Join(details, ", "))
+		b.WriteString(nested.String())
+		written = true
+	}
+
+	return written
+}
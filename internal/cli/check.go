@@ -0,0 +1,196 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/terraform-docs/terraform-docs/format"
+)
+
+// List of sections, in the order they are generated by default, which are
+// reported as changed in check mode.
+var checkSections = []string{
+	"header",
+	"requirements",
+	"providers",
+	"modules",
+	"resources",
+	"inputs",
+	"outputs",
+	"checks",
+	"migrations",
+	"footer",
+}
+
+// outOfDateError is returned in check mode when the content of the output
+// file is different from the generated content.
+type outOfDateError struct {
+	file string
+}
+
+func (e *outOfDateError) Error() string {
+	return fmt.Sprintf("%s is out of date", e.file)
+}
+
+// checkResult represents the result of checking a single output file against
+// the generated content of its module.
+type checkResult struct {
+	File     string   `json:"file"`
+	Module   string   `json:"module"`
+	UpToDate bool     `json:"up_to_date"`
+	Sections []string `json:"sections"`
+	Diff     string   `json:"diff"`
+}
+
+// checkReport represents the machine-readable report of check mode, which
+// contains the results of all the checked files (one per module in recursive
+// mode).
+type checkReport struct {
+	Results []*checkResult `json:"results"`
+}
+
+func newCheckReport() *checkReport {
+	return &checkReport{
+		Results: make([]*checkResult, 0),
+	}
+}
+
+// add the result of a checked file to the report.
+func (r *checkReport) add(result *checkResult) {
+	if r == nil {
+		return
+	}
+	r.Results = append(r.Results, result)
+}
+
+// write the report as JSON into 'filename'.
+func (r *checkReport) write(filename string) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(r); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Clean(filename), buf.Bytes(), 0644)
+}
+
+// formatterSections returns the content of individual sections generated by
+// 'formatter', keyed by their names.
+func formatterSections(formatter format.Type) map[string]string {
+	return map[string]string{
+		"header":       formatter.Header(),
+		"requirements": formatter.Requirements(),
+		"providers":    formatter.Providers(),
+		"modules":      formatter.Modules(),
+		"resources":    formatter.Resources(),
+		"inputs":       formatter.Inputs(),
+		"outputs":      formatter.Outputs(),
+		"checks":       formatter.Checks(),
+		"migrations":   formatter.Migrations(),
+		"footer":       formatter.Footer(),
+	}
+}
+
+// unifiedDiff returns the unified diff between the current content of
+// 'filename' and its generated content.
+func unifiedDiff(filename string, current string, generated string) string {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(current),
+		B:        splitLines(generated),
+		FromFile: filename,
+		FromDate: "current",
+		ToFile:   filename,
+		ToDate:   "generated",
+		Context:  3,
+	})
+	if err != nil {
+		return "" // absorb the error, diff is only informational
+	}
+	return diff
+}
+
+// changedSections returns the names of the sections whose generated content
+// differs from the current content. Changed lines are mapped to sections based
+// on where each section is located in the generated content.
+func changedSections(current string, generated string, sections map[string]string) []string {
+	type span struct {
+		start, end int // lines [start, end) of generated content
+	}
+
+	spans := make(map[string]span)
+	for name, content := range sections {
+		content = strings.TrimSpace(content)
+		if content == "" {
+			continue
+		}
+		index := strings.Index(generated, content)
+		if index < 0 {
+			continue
+		}
+		start := strings.Count(generated[:index], "\n")
+		spans[name] = span{start: start, end: start + strings.Count(content, "\n") + 1}
+	}
+
+	within := func(start, end int) []string {
+		names := make([]string, 0)
+		for name, s := range spans {
+			if start < s.end && s.start < end {
+				names = append(names, name)
+			}
+		}
+		return names
+	}
+
+	changed := make(map[string]bool)
+	matcher := difflib.NewMatcher(splitLines(current), splitLines(generated))
+	for _, op := range matcher.GetOpCodes() {
+		if op.Tag == 'e' {
+			continue
+		}
+		names := within(op.J1, op.J2)
+		if op.Tag == 'd' {
+			// lines are deleted, attribute them to the preceding line or,
+			// if it's not part of any section, to the following line.
+			if names = within(op.J1-1, op.J1); len(names) == 0 {
+				names = within(op.J1, op.J1+1)
+			}
+		}
+		for _, name := range names {
+			changed[name] = true
+		}
+	}
+
+	result := make([]string, 0, len(changed))
+	for _, name := range checkSections {
+		if changed[name] {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// splitLines splits 's' into lines, keeping their line endings.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+func TestChangedSections(t *testing.T) {
+	sections := map[string]string{
+		"header":  "# Module",
+		"inputs":  "## Inputs\n\n- foo\n- bar",
+		"outputs": "## Outputs\n\n- baz",
+		"footer":  "",
+	}
+	generated := "# Module\n\n## Inputs\n\n- foo\n- bar\n\n## Outputs\n\n- baz\n"
+
+	tests := map[string]struct {
+		current  string
+		expected []string
+	}{
+		"UpToDate": {
+			current:  generated,
+			expected: []string{},
+		},
+		"ChangedLine": {
+			current:  "# Module\n\n## Inputs\n\n- foo\n- qux\n\n## Outputs\n\n- baz\n",
+			expected: []string{"inputs"},
+		},
+		"AddedLine": {
+			current:  "# Module\n\n## Inputs\n\n- foo\n- bar\n\n## Outputs\n\n",
+			expected: []string{"outputs"},
+		},
+		"DeletedLine": {
+			current:  "# Module\n\n## Inputs\n\n- foo\n- bar\n- qux\n\n## Outputs\n\n- baz\n",
+			expected: []string{"inputs"},
+		},
+		"MultipleSections": {
+			current:  "# Old Module\n\n## Inputs\n\n- foo\n- bar\n\n## Outputs\n\n- qux\n",
+			expected: []string{"header", "outputs"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := changedSections(tt.current, generated, sections)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	assert := assert.New(t)
+
+	expected := "--- README.md\tcurrent\n" +
+		"+++ README.md\tgenerated\n" +
+		"@@ -1,3 +1,3 @@\n" +
+		" foo\n" +
+		"-bar\n" +
+		"+baz\n" +
+		" qux\n"
+
+	actual := unifiedDiff("README.md", "foo\nbar\nqux\n", "foo\nbaz\nqux\n")
+
+	assert.Equal(expected, actual)
+	assert.Equal("", unifiedDiff("README.md", "foo\n", "foo\n"))
+}
+
+func TestCheckReport(t *testing.T) {
+	assert := assert.New(t)
+
+	report := newCheckReport()
+
+	for _, file := range []string{"mode-replace.md", "mode-inject.md"} {
+		writer := &fileWriter{
+			file: file,
+			dir:  filepath.Join("testdata", "writer"),
+
+			mode: print.OutputModeReplace,
+
+			check:    true,
+			sections: map[string]string{"inputs": "Lorem ipsum"},
+			report:   report,
+			stdout:   &bytes.Buffer{},
+
+			template: print.OutputContent,
+		}
+
+		_, err := io.WriteString(writer, "Lorem ipsum\n")
+		assert.NotNil(err)
+	}
+
+	filename := filepath.Join(t.TempDir(), "report.json")
+	assert.Nil(report.write(filename))
+
+	content, err := os.ReadFile(filename)
+	assert.Nil(err)
+
+	actual := &checkReport{}
+	assert.Nil(json.Unmarshal(content, actual))
+
+	assert.Len(actual.Results, 2)
+	for _, result := range actual.Results {
+		assert.Equal(filepath.Join("testdata", "writer"), result.Module)
+		assert.False(result.UpToDate)
+		assert.Equal([]string{"inputs"}, result.Sections)
+		assert.NotEmpty(result.Diff)
+	}
+	assert.Equal(filepath.Join("testdata", "writer", "mode-replace.md"), actual.Results[0].File)
+	assert.Equal(filepath.Join("testdata", "writer", "mode-inject.md"), actual.Results[1].File)
+}
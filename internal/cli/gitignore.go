@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// gitignore matches paths against the rules of '.gitignore' files found in
+// the module root and its subdirectories.
+type gitignore struct {
+	root  string
+	rules []gitignoreRule
+}
+
+// gitignoreRule represents a single pattern of a '.gitignore' file.
+type gitignoreRule struct {
+	base    string // directory of the '.gitignore' file, relative to root
+	pattern string
+	negate  bool
+	dirOnly bool
+}
+
+func newGitignore(root string) *gitignore {
+	return &gitignore{
+		root:  root,
+		rules: make([]gitignoreRule, 0),
+	}
+}
+
+// load the rules of '.gitignore' file in 'dir', if exists.
+func (g *gitignore) load(dir string) error {
+	f, err := os.Open(filepath.Join(dir, ".gitignore"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close() //nolint:errcheck
+
+	base, err := filepath.Rel(g.root, dir)
+	if err != nil {
+		return err
+	}
+	base = filepath.ToSlash(base)
+	if base == "." {
+		base = ""
+	}
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if rule, ok := parseGitignoreRule(base, scanner.Text()); ok {
+			g.rules = append(g.rules, rule)
+		}
+	}
+	return scanner.Err()
+}
+
+// loadPath loads the rules of '.gitignore' files of root and of every
+// directory on the path from root down to 'dir', in that order.
+func (g *gitignore) loadPath(dir string) error {
+	if err := g.load(g.root); err != nil {
+		return err
+	}
+	rel, err := filepath.Rel(g.root, dir)
+	if err != nil {
+		return err
+	}
+	rel = filepath.ToSlash(rel)
+	if rel == "." {
+		return nil
+	}
+	if rel == ".." || strings.HasPrefix(rel, "../") {
+		return g.load(dir) // 'dir' is not under root
+	}
+	current := g.root
+	for _, name := range strings.Split(rel, "/") {
+		current = filepath.Join(current, name)
+		if err := g.load(current); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// match reports whether 'rel' (slash separated and relative to root) is
+// ignored. As in git, the last matching rule wins.
+//
+// Unlike git, rules are not matched against the parent directories of 'rel',
+// i.e. the content of an ignored directory is not ignored by itself. This is
+// fine as long as directories are walked from the top, and the ignored ones
+// are skipped (with 'filepath.SkipDir') before their content is reached.
+func (g *gitignore) match(rel string, isDir bool) bool {
+	ignored := false
+	for _, rule := range g.rules {
+		if rule.match(rel, isDir) {
+			ignored = !rule.negate
+		}
+	}
+	return ignored
+}
+
+func parseGitignoreRule(base string, line string) (gitignoreRule, bool) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return gitignoreRule{}, false
+	}
+
+	rule := gitignoreRule{base: base}
+
+	if strings.HasPrefix(line, "!") {
+		rule.negate = true
+		line = line[1:]
+	}
+	line = strings.TrimPrefix(line, `\`) // escaped leading '#' or '!'
+
+	if strings.HasSuffix(line, "/") {
+		rule.dirOnly = true
+		line = strings.TrimSuffix(line, "/")
+	}
+
+	// patterns without a slash match at any level below the '.gitignore'
+	// file, otherwise they are relative to it.
+	if strings.Contains(line, "/") {
+		rule.pattern = strings.TrimPrefix(line, "/")
+	} else {
+		rule.pattern = "**/" + line
+	}
+
+	if rule.pattern == "" || !doublestar.ValidatePattern(rule.pattern) {
+		return gitignoreRule{}, false
+	}
+	return rule, true
+}
+
+func (r gitignoreRule) match(rel string, isDir bool) bool {
+	if r.dirOnly && !isDir {
+		return false
+	}
+	if r.base != "" {
+		if !strings.HasPrefix(rel, r.base+"/") {
+			return false
+		}
+		rel = strings.TrimPrefix(rel, r.base+"/")
+	}
+	matched, _ := doublestar.Match(r.pattern, path.Clean(rel))
+	return matched
+}
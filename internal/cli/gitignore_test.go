@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitignore(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "modules"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		".gitignore":         "# comment\n\nvendor/\n/build\n*.tmp\ncache/**\n!cache/keep\n",
+		"modules/.gitignore": "generated\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(root, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ignore := newGitignore(root)
+	assert.Nil(t, ignore.load(root))
+	assert.Nil(t, ignore.load(filepath.Join(root, "modules")))
+	assert.Nil(t, ignore.load(filepath.Join(root, "missing")))
+
+	tests := map[string]struct {
+		path     string
+		isDir    bool
+		expected bool
+	}{
+		"NotIgnored":             {path: "modules/foo", isDir: true, expected: false},
+		"DirectoryOnly":          {path: "modules/vendor", isDir: true, expected: true},
+		"DirectoryOnlyFile":      {path: "modules/vendor", isDir: false, expected: false},
+		"Anchored":               {path: "build", isDir: true, expected: true},
+		"AnchoredNested":         {path: "modules/build", isDir: true, expected: false},
+		"Wildcard":               {path: "modules/foo.tmp", isDir: false, expected: true},
+		"DoubleStar":             {path: "cache/foo", isDir: true, expected: true},
+		"Negated":                {path: "cache/keep", isDir: true, expected: false},
+		"NestedGitignore":        {path: "modules/a/generated", isDir: true, expected: true},
+		"NestedGitignoreOutside": {path: "generated", isDir: true, expected: false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ignore.match(tt.path, tt.isDir))
+		})
+	}
+}
@@ -0,0 +1,257 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+// Default templates of the index of submodules.
+const (
+	markdownIndexTemplate = `# Modules
+
+| Name | Description | Required Inputs | Providers |
+| ---- | ----------- | :-------------: | --------- |
+{{- range .Modules }}
+| [{{ .Name }}]({{ .Link }}) | {{ default "n/a" .Summary | escapePipe }} | {{ .RequiredInputs }} | {{ join ", " .Providers }} |
+{{- end }}
+`
+
+	asciidocIndexTemplate = `= Modules
+
+[cols="a,a,a,a",options="header,autowidth"]
+|===
+|Name |Description |Required Inputs |Providers
+{{- range .Modules }}
+|link:{{ .Link }}[{{ .Name }}]
+|{{ default "n/a" .Summary | escapePipe }}
+|{{ .RequiredInputs }}
+|{{ join ", " .Providers }}
+{{ end }}
+|===
+`
+
+	rstIndexTemplate = `Modules
+=======
+
+.. list-table::
+   :header-rows: 1
+
+   * - Name
+     - Description
+     - Required Inputs
+     - Providers
+{{- range .Modules }}
+   * - ` + "`{{ .Name }} <{{ .Link }}>`__" + `
+     - {{ default "n/a" .Summary }}
+     - {{ .RequiredInputs }}
+     - {{ join ", " .Providers }}
+{{- end }}
+`
+)
+
+// indexEntry represents a submodule listed in the index.
+type indexEntry struct {
+	Name           string   // path of the submodule relative to the main module
+	Link           string   // path of the submodule document relative to the index
+	Summary        string   // first paragraph of the submodule header
+	RequiredInputs int      // number of required inputs
+	Providers      []string // names of the providers used
+}
+
+// generateIndex generates the index of all the successfully generated
+// submodules in 'results', and writes it to `recursive.index.file`.
+func (r *Runtime) generateIndex(results []*moduleResult, report *checkReport) error {
+	file := r.indexFile()
+	filename := file
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(r.rootDir, filename)
+	}
+
+	entries := make([]*indexEntry, 0, len(results))
+	for _, result := range results {
+		if result.err != nil || result.tfmodule == nil || result.rootDir == r.rootDir {
+			continue
+		}
+		entry, err := newIndexEntry(r.rootDir, filename, result)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+	}
+
+	content, err := renderIndex(r.indexTemplate(), entries)
+	if err != nil {
+		return err
+	}
+
+	if !r.config.Output.Check {
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			return err
+		}
+	}
+
+	w := &fileWriter{
+		file: file,
+		dir:  r.rootDir,
+
+		mode: print.OutputModeReplace,
+
+		check:  r.config.Output.Check,
+		report: report,
+		stdout: os.Stdout,
+	}
+
+	_, err = io.WriteString(w, content)
+
+	return err
+}
+
+// indexFile returns the file of the index, either the one provided by
+// `recursive.index.file` or 'MODULES' with the extension of the formatter.
+func (r *Runtime) indexFile() string {
+	if r.config.Recursive.Index.File != "" {
+		return r.config.Recursive.Index.File
+	}
+	switch {
+	case r.isAsciidoc():
+		return "MODULES.adoc"
+	case r.isRST():
+		return "MODULES.rst"
+	default:
+		return "MODULES.md"
+	}
+}
+
+// indexTemplate returns the template of the index, either the one provided by
+// `recursive.index.template` or the default one based on the formatter.
+func (r *Runtime) indexTemplate() string {
+	if r.config.Recursive.Index.Template != "" {
+		return strings.ReplaceAll(r.config.Recursive.Index.Template, "\\n", "\n")
+	}
+	switch {
+	case r.isAsciidoc():
+		return asciidocIndexTemplate
+	case r.isRST():
+		return rstIndexTemplate
+	default:
+		return markdownIndexTemplate
+	}
+}
+
+func (r *Runtime) isAsciidoc() bool {
+	return strings.HasPrefix(r.config.Formatter, "asciidoc") || strings.HasPrefix(r.config.Formatter, "adoc")
+}
+
+func (r *Runtime) isRST() bool {
+	return strings.HasPrefix(r.config.Formatter, "rst")
+}
+
+func newIndexEntry(rootDir string, index string, result *moduleResult) (*indexEntry, error) {
+	name, err := relativePath(rootDir, result.rootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	document := result.config.Output.File
+	if !filepath.IsAbs(document) {
+		document = filepath.Join(result.rootDir, document)
+	}
+	link, err := relativePath(filepath.Dir(index), document)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := []string{}
+	for _, p := range result.tfmodule.Providers {
+		if !slices.Contains(providers, p.Name) {
+			providers = append(providers, p.Name)
+		}
+	}
+
+	return &indexEntry{
+		Name:           name,
+		Link:           link,
+		Summary:        headerSummary(result.tfmodule.Header),
+		RequiredInputs: len(result.tfmodule.RequiredInputs),
+		Providers:      providers,
+	}, nil
+}
+
+func renderIndex(text string, entries []*indexEntry) (string, error) {
+	tmpl, err := template.New("index").Funcs(template.FuncMap{
+		"default": func(fallback string, value string) string {
+			if value == "" {
+				return fallback
+			}
+			return value
+		},
+		"escapePipe": func(s string) string {
+			return strings.ReplaceAll(s, "|", "\\|")
+		},
+		"join": func(sep string, items []string) string {
+			return strings.Join(items, sep)
+		},
+	}).Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, struct {
+		Modules []*indexEntry
+	}{entries})
+
+	return buf.String(), err
+}
+
+// headerSummary returns the first paragraph of the module header, skipping
+// the leading headings, as a single line. Headings are either Markdown and
+// Asciidoc ones (e.g. '# Title' or '= Title') or reStructuredText ones, i.e.
+// a title followed (and optionally preceded) by an underline.
+func headerSummary(header string) string {
+	all := strings.Split(strings.ReplaceAll(header, "\r\n", "\n"), "\n")
+	lines := []string{}
+	for i := 0; i < len(all); i++ {
+		line := strings.TrimSpace(all[i])
+		if len(lines) == 0 {
+			if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "=") || isUnderline(line) {
+				continue
+			}
+			if i+1 < len(all) && isUnderline(strings.TrimSpace(all[i+1])) {
+				i++ // skip both the title and its underline
+				continue
+			}
+		}
+		if line == "" {
+			break
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, " ")
+}
+
+// isUnderline returns true if 'line' is a reStructuredText section adornment,
+// i.e. a repetition of a single punctuation character (e.g. '=====').
+func isUnderline(line string) bool {
+	if len(line) < 2 || !strings.ContainsRune("=-`:'\"~^_*+#<>.", rune(line[0])) {
+		return false
+	}
+	return strings.Trim(line, line[:1]) == ""
+}
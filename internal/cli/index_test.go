@@ -0,0 +1,262 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+func TestHeaderSummary(t *testing.T) {
+	tests := map[string]struct {
+		header   string
+		expected string
+	}{
+		"Empty": {
+			header:   "",
+			expected: "",
+		},
+		"OnlyHeading": {
+			header:   "# Foo",
+			expected: "",
+		},
+		"MarkdownHeading": {
+			header:   "# Foo\n\nLorem ipsum dolor sit amet,\nconsectetur adipiscing elit.\n\nSed do eiusmod.",
+			expected: "Lorem ipsum dolor sit amet, consectetur adipiscing elit.",
+		},
+		"AsciidocHeading": {
+			header:   "= Foo\r\n\r\nLorem ipsum.\r\n",
+			expected: "Lorem ipsum.",
+		},
+		"NoHeading": {
+			header:   "Lorem ipsum.\n\n## Usage",
+			expected: "Lorem ipsum.",
+		},
+		"RSTHeading": {
+			header:   "Foo\n===\n\nLorem ipsum.",
+			expected: "Lorem ipsum.",
+		},
+		"RSTHeadingOverline": {
+			header:   "=====\n Foo\n=====\n\nBar\n---\n\nLorem ipsum.",
+			expected: "Lorem ipsum.",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, headerSummary(tt.header))
+		})
+	}
+}
+
+func TestIndexFile(t *testing.T) {
+	tests := map[string]struct {
+		formatter string
+		file      string
+		expected  string
+	}{
+		"Markdown": {
+			formatter: "markdown table",
+			file:      "",
+			expected:  "MODULES.md",
+		},
+		"Asciidoc": {
+			formatter: "asciidoc document",
+			file:      "",
+			expected:  "MODULES.adoc",
+		},
+		"RST": {
+			formatter: "rst",
+			file:      "",
+			expected:  "MODULES.rst",
+		},
+		"Pretty": {
+			formatter: "pretty",
+			file:      "",
+			expected:  "MODULES.md",
+		},
+		"Custom": {
+			formatter: "asciidoc",
+			file:      "docs/INDEX.md",
+			expected:  "docs/INDEX.md",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := print.DefaultConfig()
+			config.Formatter = tt.formatter
+			config.Recursive.Index.File = tt.file
+
+			runtime := &Runtime{config: config}
+
+			assert.Equal(t, tt.expected, runtime.indexFile())
+		})
+	}
+}
+
+func TestGenerateIndex(t *testing.T) {
+	root := t.TempDir()
+
+	results := []*moduleResult{
+		{
+			rootDir:  root,
+			config:   &print.Config{Output: print.DefaultConfig().Output},
+			tfmodule: &terraform.Module{Header: "# Main"},
+		},
+		{
+			rootDir: filepath.Join(root, "modules", "db"),
+			config:  &print.Config{},
+			err:     errors.New("failed"),
+		},
+		{
+			rootDir: filepath.Join(root, "modules", "vpc"),
+			tfmodule: &terraform.Module{
+				Header: "# VPC\n\nCreates a VPC | with subnets.",
+				Providers: []*terraform.Provider{
+					{Name: "aws"},
+					{Name: "aws", Alias: "east"},
+					{Name: "null"},
+				},
+				RequiredInputs: []*terraform.Input{{Name: "cidr"}, {Name: "name"}},
+			},
+		},
+		{
+			rootDir:  filepath.Join(root, "modules", "empty"),
+			tfmodule: &terraform.Module{},
+		},
+	}
+
+	tests := map[string]struct {
+		formatter string
+		file      string
+		template  string
+		expected  string
+	}{
+		"Markdown": {
+			formatter: "markdown table",
+			file:      "MODULES.md",
+			template:  "",
+			expected: "# Modules\n\n" +
+				"| Name | Description | Required Inputs | Providers |\n" +
+				"| ---- | ----------- | :-------------: | --------- |\n" +
+				"| [modules/vpc](modules/vpc/README.md) | Creates a VPC \\| with subnets. | 2 | aws, null |\n" +
+				"| [modules/empty](modules/empty/README.md) | n/a | 0 |  |\n",
+		},
+		"Asciidoc": {
+			formatter: "asciidoc",
+			file:      "docs/MODULES.adoc",
+			template:  "",
+			expected: "= Modules\n\n" +
+				"[cols=\"a,a,a,a\",options=\"header,autowidth\"]\n" +
+				"|===\n" +
+				"|Name |Description |Required Inputs |Providers\n" +
+				"|link:../modules/vpc/README.md[modules/vpc]\n" +
+				"|Creates a VPC \\| with subnets.\n" +
+				"|2\n" +
+				"|aws, null\n\n" +
+				"|link:../modules/empty/README.md[modules/empty]\n" +
+				"|n/a\n" +
+				"|0\n" +
+				"|\n\n" +
+				"|===\n",
+		},
+		"RST": {
+			formatter: "rst table",
+			file:      "",
+			template:  "",
+			expected: "Modules\n=======\n\n" +
+				".. list-table::\n" +
+				"   :header-rows: 1\n\n" +
+				"   * - Name\n" +
+				"     - Description\n" +
+				"     - Required Inputs\n" +
+				"     - Providers\n" +
+				"   * - `modules/vpc <modules/vpc/README.md>`__\n" +
+				"     - Creates a VPC | with subnets.\n" +
+				"     - 2\n" +
+				"     - aws, null\n" +
+				"   * - `modules/empty <modules/empty/README.md>`__\n" +
+				"     - n/a\n" +
+				"     - 0\n" +
+				"     - \n",
+		},
+		"CustomTemplate": {
+			formatter: "markdown table",
+			file:      "INDEX.md",
+			template:  "{{ range .Modules }}- {{ .Name }}: {{ .RequiredInputs }}\\n{{ end }}",
+			expected:  "- modules/vpc: 2\n- modules/empty: 0\n",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.DefaultConfig()
+			config.Formatter = tt.formatter
+			config.Recursive.Index.File = tt.file
+			config.Recursive.Index.Template = tt.template
+
+			for _, result := range results[2:] {
+				result.config = &print.Config{Output: print.DefaultConfig().Output}
+				result.config.Output.File = "README.md"
+			}
+
+			runtime := &Runtime{rootDir: root, config: config}
+
+			assert.Nil(runtime.generateIndex(results, nil))
+
+			actual, err := os.ReadFile(filepath.Join(root, runtime.indexFile()))
+			assert.Nil(err)
+			assert.Equal(tt.expected, string(actual))
+		})
+	}
+}
+
+func TestGenerateIndexCheckMissing(t *testing.T) {
+	assert := assert.New(t)
+
+	root := t.TempDir()
+
+	results := []*moduleResult{
+		{
+			rootDir:  filepath.Join(root, "modules", "vpc"),
+			config:   &print.Config{Output: print.DefaultConfig().Output},
+			tfmodule: &terraform.Module{Header: "# VPC\n\nCreates a VPC."},
+		},
+	}
+
+	config := print.DefaultConfig()
+	config.Formatter = "markdown table"
+	config.Output.Check = true
+	config.Recursive.Index.File = "MODULES.md"
+
+	runtime := &Runtime{rootDir: root, config: config}
+	report := newCheckReport()
+
+	err := runtime.generateIndex(results, report)
+
+	var oerr *outOfDateError
+	assert.True(errors.As(err, &oerr))
+
+	assert.Len(report.Results, 1)
+	assert.Equal(filepath.Join(root, "MODULES.md"), report.Results[0].File)
+	assert.False(report.Results[0].UpToDate)
+	assert.NotEmpty(report.Results[0].Diff)
+
+	_, err = os.Stat(filepath.Join(root, "MODULES.md"))
+	assert.True(errors.Is(err, os.ErrNotExist))
+}
@@ -27,19 +27,35 @@ var flagMappings = map[string]string{
 	"output-values":      "output-values.enabled",
 	"output-values-from": "output-values.from",
 
+	"example-values":      "example-values.enabled",
+	"example-values-from": "example-values.from",
+
+	"usage-name":    "usage.name",
+	"usage-source":  "usage.source",
+	"usage-version": "usage.version",
+
+	"theme":      "html.theme",
+	"stylesheet": "html.stylesheet",
+
 	"sort":             "sort.enabled",
 	"sort-by":          "sort.by",
 	"sort-by-required": "required",
 	"sort-by-type":     "type",
 
-	"anchor":        "settings.anchor",
-	"color":         "settings.color",
-	"default":       "settings.default",
-	"description":   "settings.description",
-	"escape":        "settings.escape",
-	"indent":        "settings.indent",
-	"read-comments": "settings.read-comments",
-	"required":      "settings.required",
-	"sensitive":     "settings.sensitive",
-	"type":          "settings.type",
+	"anchor":           "settings.anchor",
+	"attributes":       "settings.attributes",
+	"color":            "settings.color",
+	"default":          "settings.default",
+	"description":      "settings.description",
+	"ephemeral":        "settings.ephemeral",
+	"escape":           "settings.escape",
+	"indent":           "settings.indent",
+	"nested-resources": "settings.nested-resources",
+	"nullable":         "settings.nullable",
+	"read-comments":    "settings.read-comments",
+	"required":         "settings.required",
+	"sensitive":        "settings.sensitive",
+	"source":           "settings.source",
+	"type":             "settings.type",
+	"validation":       "settings.validation",
 }
@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/terraform-docs/terraform-docs/internal/plugin"
+	pluginsdk "github.com/terraform-docs/terraform-docs/plugin"
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+// pluginList is the set of plugins used by formatters and the enricher and
+// post-processor pipelines. Plugins are looked up and launched lazily.
+type pluginList interface {
+	Formatter(config *print.Config, name string) (pluginsdk.Formatter, error)
+	Enricher(config *print.Config, name string) (pluginsdk.Enricher, error)
+	PostProcessor(config *print.Config, name string) (pluginsdk.PostProcessor, error)
+	Clean()
+}
+
+// Ensure plugin.List fully satisfy pluginList interface.
+var _ pluginList = &plugin.List{}
+
+// enrichModule passes the loaded 'module' through the enricher plugins of
+// 'plugins.enrichers', in order. Each of them receives the module returned
+// by the previous one.
+func enrichModule(config *print.Config, module *terraform.Module, plugins pluginList) (*terraform.Module, error) {
+	if len(config.Plugins.Enrichers) == 0 {
+		return module, nil
+	}
+	for _, name := range config.Plugins.Enrichers {
+		enricher, err := plugins.Enricher(config, name)
+		if err != nil {
+			return nil, err
+		}
+
+		module, err = enricher.Enrich(&pluginsdk.ExecuteArgs{
+			Module: module,
+			Config: config,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("enricher '%s': %w", name, err)
+		}
+	}
+
+	// the module returned by plugins is rebuilt from the protocol, and has to
+	// be attached to the registry config again
+	terraform.AttachRegistry(module, config)
+
+	return module, nil
+}
+
+// postProcessContent passes the generated 'content' through the post-processor
+// plugins of 'plugins.post-processors', in order. Each of them receives the
+// content returned by the previous one.
+func postProcessContent(config *print.Config, content string, plugins pluginList) (string, error) {
+	for _, name := range config.Plugins.PostProcessors {
+		postProcessor, err := plugins.PostProcessor(config, name)
+		if err != nil {
+			return "", err
+		}
+
+		content, err = postProcessor.PostProcess(&pluginsdk.PostProcessArgs{
+			Content: content,
+			Config:  config,
+		})
+		if err != nil {
+			return "", fmt.Errorf("post-processor '%s': %w", name, err)
+		}
+	}
+
+	return content, nil
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pluginsdk "github.com/terraform-docs/terraform-docs/plugin"
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+type fakePlugin struct {
+	name    string
+	err     error
+	rebuild bool
+}
+
+func (p *fakePlugin) Name() (string, error) {
+	return p.name, nil
+}
+
+func (p *fakePlugin) Enrich(args *pluginsdk.ExecuteArgs) (*terraform.Module, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	args.Module.Footer += p.name
+	if !p.rebuild {
+		return args.Module, nil
+	}
+
+	// the same way modules are rebuilt from the plugin protocol
+	module := *args.Module
+	module.Resources = make([]*terraform.Resource, 0, len(args.Module.Resources))
+	for _, r := range args.Module.Resources {
+		module.Resources = append(module.Resources, &terraform.Resource{
+			Type:           r.Type,
+			Name:           r.Name,
+			ProviderName:   r.ProviderName,
+			ProviderSource: r.ProviderSource,
+			Mode:           r.Mode,
+			Version:        r.Version,
+		})
+	}
+	return &module, nil
+}
+
+func (p *fakePlugin) PostProcess(args *pluginsdk.PostProcessArgs) (string, error) {
+	if p.err != nil {
+		return "", p.err
+	}
+	return args.Content + p.name, nil
+}
+
+type fakePlugins struct {
+	plugins map[string]*fakePlugin
+}
+
+func (l *fakePlugins) Formatter(_ *print.Config, name string) (pluginsdk.Formatter, error) {
+	return nil, fmt.Errorf("formatter '%s' not found", name)
+}
+
+func (l *fakePlugins) Enricher(_ *print.Config, name string) (pluginsdk.Enricher, error) {
+	p, ok := l.plugins[name]
+	if !ok {
+		return nil, fmt.Errorf("enricher '%s' not found", name)
+	}
+	return p, nil
+}
+
+func (l *fakePlugins) PostProcessor(_ *print.Config, name string) (pluginsdk.PostProcessor, error) {
+	p, ok := l.plugins[name]
+	if !ok {
+		return nil, fmt.Errorf("post-processor '%s' not found", name)
+	}
+	return p, nil
+}
+
+func (l *fakePlugins) Clean() {}
+
+func TestEnrichModule(t *testing.T) {
+	tests := map[string]struct {
+		enrichers []string
+		plugins   *fakePlugins
+		expected  string
+		wantErr   string
+	}{
+		"NoEnrichers": {
+			enrichers: []string{},
+			plugins:   &fakePlugins{},
+			expected:  "",
+		},
+		"InOrder": {
+			enrichers: []string{"a", "b", "c"},
+			plugins: &fakePlugins{plugins: map[string]*fakePlugin{
+				"a": {name: "a"},
+				"b": {name: "b"},
+				"c": {name: "c"},
+			}},
+			expected: "abc",
+		},
+		"NotFound": {
+			enrichers: []string{"a", "b"},
+			plugins: &fakePlugins{plugins: map[string]*fakePlugin{
+				"a": {name: "a"},
+			}},
+			wantErr: "enricher 'b' not found",
+		},
+		"Error": {
+			enrichers: []string{"a"},
+			plugins: &fakePlugins{plugins: map[string]*fakePlugin{
+				"a": {name: "a", err: errors.New("something went wrong")},
+			}},
+			wantErr: "enricher 'a': something went wrong",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.DefaultConfig()
+			config.Plugins.Enrichers = tt.enrichers
+
+			module, err := enrichModule(config, &terraform.Module{}, tt.plugins)
+
+			if tt.wantErr != "" {
+				assert.EqualError(err, tt.wantErr)
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, module.Footer)
+			}
+		})
+	}
+}
+
+func TestEnrichModuleRegistry(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.DefaultConfig()
+	config.Registry.Host = "registry.opentofu.org"
+	config.Plugins.Enrichers = []string{"a"}
+
+	module := &terraform.Module{
+		Resources: []*terraform.Resource{
+			{
+				Type:           "instance",
+				Name:           "this",
+				ProviderName:   "aws",
+				ProviderSource: "hashicorp/aws",
+				Mode:           "managed",
+				Version:        "5.31.0",
+			},
+		},
+	}
+	plugins := &fakePlugins{plugins: map[string]*fakePlugin{
+		"a": {name: "a", rebuild: true},
+	}}
+
+	actual, err := enrichModule(config, module, plugins)
+	assert.Nil(err)
+	assert.Equal("https://search.opentofu.org/provider/hashicorp/aws/5.31.0/docs/resources/instance", actual.Resources[0].URL())
+}
+
+func TestPostProcessContent(t *testing.T) {
+	tests := map[string]struct {
+		postProcessors []string
+		plugins        *fakePlugins
+		expected       string
+		wantErr        string
+	}{
+		"NoPostProcessors": {
+			postProcessors: []string{},
+			plugins:        &fakePlugins{},
+			expected:       "content:",
+		},
+		"InOrder": {
+			postProcessors: []string{"c", "b", "a"},
+			plugins: &fakePlugins{plugins: map[string]*fakePlugin{
+				"a": {name: "a"},
+				"b": {name: "b"},
+				"c": {name: "c"},
+			}},
+			expected: "content:cba",
+		},
+		"NotFound": {
+			postProcessors: []string{"b"},
+			plugins: &fakePlugins{plugins: map[string]*fakePlugin{
+				"a": {name: "a"},
+			}},
+			wantErr: "post-processor 'b' not found",
+		},
+		"Error": {
+			postProcessors: []string{"a"},
+			plugins: &fakePlugins{plugins: map[string]*fakePlugin{
+				"a": {name: "a", err: errors.New("something went wrong")},
+			}},
+			wantErr: "post-processor 'a': something went wrong",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.DefaultConfig()
+			config.Plugins.PostProcessors = tt.postProcessors
+
+			content, err := postProcessContent(config, "content:", tt.plugins)
+
+			if tt.wantErr != "" {
+				assert.EqualError(err, tt.wantErr)
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, content)
+			}
+		})
+	}
+}
+
+func TestGenerateContentPostProcessors(t *testing.T) {
+	assert := assert.New(t)
+
+	plugins := &fakePlugins{plugins: map[string]*fakePlugin{
+		"footer": {name: "\n<!-- processed -->"},
+	}}
+
+	config := print.DefaultConfig()
+	config.Formatter = "markdown table"
+	config.Content = "content"
+	config.Plugins.PostProcessors = []string{"footer"}
+
+	var buf strings.Builder
+	err := generateContent(config, &terraform.Module{}, plugins, nil, &buf)
+
+	assert.Nil(err)
+	assert.Equal("content\n<!-- processed -->\n", buf.String())
+}
+
+func TestGenerateContentPluginFormatter(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.DefaultConfig()
+	config.Formatter = "foo"
+
+	var buf strings.Builder
+	err := generateContent(config, &terraform.Module{}, &fakePlugins{}, nil, &buf)
+
+	assert.EqualError(err, "formatter 'foo' not found")
+	assert.Empty(buf.String())
+}
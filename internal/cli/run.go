@@ -11,6 +11,7 @@ the root directory of this source tree.
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -19,6 +20,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/bmatcuk/doublestar/v4"
 	goversion "github.com/hashicorp/go-version"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -41,6 +43,8 @@ type Runtime struct {
 
 	cmd           *cobra.Command
 	isFlagChanged func(string) bool
+
+	plugins pluginList
 }
 
 // NewRuntime returns new instance of Runtime. If `config` is not provided
@@ -49,7 +53,7 @@ func NewRuntime(config *print.Config) *Runtime {
 	if config == nil {
 		config = print.DefaultConfig()
 	}
-	return &Runtime{config: config}
+	return &Runtime{config: config, plugins: plugin.NewList()}
 }
 
 // PreRunEFunc is the 'cobra.Command#PreRunE' function for 'formatter'
@@ -88,6 +92,28 @@ func (r *Runtime) PreRunEFunc(cmd *cobra.Command, args []string) error {
 	return checkConstraint(r.config.Version, version.Core())
 }
 
+// ReadConfig reads config file of module in 'rootDir', if exists, and
+// overrides it with flags of 'cmd'. This is used by commands other than
+// 'formatter' commands, e.g. 'plugin list', which don't generate content.
+func (r *Runtime) ReadConfig(cmd *cobra.Command, rootDir string) error {
+	r.formatter = cmd.Annotations["command"]
+	r.isFlagChanged = cmd.Flags().Changed
+	r.rootDir = rootDir
+	r.cmd = cmd
+
+	if r.config.File == "" {
+		return fmt.Errorf("value of '--config' can't be empty")
+	}
+
+	v := viper.New()
+
+	if err := r.readConfig(v, r.config.File, ""); err != nil {
+		return err
+	}
+
+	return r.unmarshalConfig(v, r.config)
+}
+
 type module struct {
 	rootDir string
 	config  *print.Config
@@ -97,6 +123,9 @@ type module struct {
 // to discover submodules, on `--recursive` flag, and generates the content for them
 // as well as the root module.
 func (r *Runtime) RunEFunc(cmd *cobra.Command, args []string) error { //nolint:gocyclo
+	// plugins are launched on first use and shared between all the modules
+	defer r.plugins.Clean()
+
 	modules := []module{}
 
 	if !r.config.Recursive.Enabled || r.config.Recursive.IncludeMain {
@@ -115,34 +144,168 @@ func (r *Runtime) RunEFunc(cmd *cobra.Command, args []string) error { //nolint:g
 		modules = append(modules, items...)
 	}
 
-	for _, module := range modules {
-		cfg := r.config
+	// In check mode, optionally keep track of the result of checking each
+	// module to write a machine-readable report at the end.
+	var report *checkReport
+	if r.config.Output.Check && r.config.Output.CheckReport != "" {
+		report = newCheckReport()
+	}
+
+	errs := []error{}
+	results := []*moduleResult{}
 
-		// If submodules contains its own configuration file, use that instead
-		if module.config != nil {
-			cfg = module.config
+	// Modules are processed concurrently but their results are collected in
+	// the order they were found, to have a deterministic output.
+	for result := range r.generateModules(modules, report != nil) {
+		os.Stdout.Write(result.stdout.Bytes()) //nolint:errcheck,gosec
+
+		if report != nil {
+			report.Results = append(report.Results, result.report.Results...)
+		}
+
+		if result.err != nil {
+			errs = append(errs, result.err)
 		}
 
-		// set the module root directory
-		cfg.ModuleRoot = module.rootDir
+		results = append(results, result)
+	}
 
-		// process and validate configuration
-		if err := cfg.Validate(); err != nil {
+	if r.config.Recursive.Enabled && r.config.Recursive.Index.Enabled {
+		if err := r.generateIndex(results, report); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if report != nil {
+		if err := report.write(r.config.Output.CheckReport); err != nil {
 			return err
 		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// moduleResult represents the result of generating content for a module.
+type moduleResult struct {
+	rootDir  string
+	config   *print.Config
+	tfmodule *terraform.Module
+
+	stdout bytes.Buffer
+	report *checkReport
+	err    error
+}
+
+// generateModules generates the content for 'modules' with a pool of workers
+// of size `recursive.parallelism`. The results are returned in the same order
+// as 'modules', and each of them is sent to the channel as soon as it, and all
+// the modules before it, are done.
+func (r *Runtime) generateModules(modules []module, withReport bool) <-chan *moduleResult {
+	results := make([]*moduleResult, len(modules))
+	done := make([]chan struct{}, len(modules))
+	for i := range modules {
+		results[i] = &moduleResult{rootDir: modules[i].rootDir}
+		if withReport {
+			results[i].report = newCheckReport()
+		}
+		done[i] = make(chan struct{})
+	}
+
+	jobs := make(chan int)
+	workers := min(max(r.config.Recursive.Parallelism, 1), len(modules))
+
+	for w := 0; w < workers; w++ {
+		go func() {
+			for i := range jobs {
+				result := results[i]
+				result.err = r.generateModule(modules[i], result)
+				close(done[i])
+			}
+		}()
+	}
 
-		if r.config.Recursive.Enabled && cfg.Output.File == "" {
-			return fmt.Errorf("value of '--output-file' cannot be empty with '--recursive'")
+	go func() {
+		for i := range modules {
+			jobs <- i
+		}
+		close(jobs)
+	}()
+
+	ordered := make(chan *moduleResult)
+	go func() {
+		for i := range modules {
+			<-done[i]
+			ordered <- results[i]
 		}
+		close(ordered)
+	}()
+
+	return ordered
+}
+
+// generateModule validates the configuration of 'module', loads and generates
+// its content. The loaded module and everything that would be printed to
+// stdout are kept in 'result'.
+func (r *Runtime) generateModule(module module, result *moduleResult) error {
+	cfg := r.config
+
+	// If submodules contains its own configuration file, use that instead
+	if module.config != nil {
+		cfg = module.config
+	}
+
+	// Modules can be processed concurrently, work on a copy of the
+	// configuration to not share it between them.
+	copy := *cfg
+	cfg = &copy
 
-		if err := generateContent(cfg); err != nil {
+	// set the module root directory
+	cfg.ModuleRoot = module.rootDir
+
+	// process and validate configuration
+	if err := cfg.Validate(); err != nil {
+		return r.moduleError(module, err)
+	}
+
+	if r.config.Recursive.Enabled && cfg.Output.File == "" {
+		return fmt.Errorf("value of '--output-file' cannot be empty with '--recursive'")
+	}
+
+	result.config = cfg
+
+	tfmodule, err := terraform.LoadWithOptions(cfg)
+	if err != nil {
+		return r.moduleError(module, err)
+	}
+
+	tfmodule, err = enrichModule(cfg, tfmodule, r.plugins)
+	if err != nil {
+		return r.moduleError(module, err)
+	}
+
+	result.tfmodule = tfmodule
+
+	if err := generateContent(cfg, tfmodule, r.plugins, result.report, &result.stdout); err != nil {
+		// out of date error already contains the path of the module
+		var oerr *outOfDateError
+		if errors.As(err, &oerr) {
 			return err
 		}
+		return r.moduleError(module, err)
 	}
 
 	return nil
 }
 
+// moduleError annotates 'err' with the path of the module in recursive mode,
+// to distinguish the errors of different modules.
+func (r *Runtime) moduleError(module module, err error) error {
+	if !r.config.Recursive.Enabled {
+		return err
+	}
+	return fmt.Errorf("%s: %w", module.rootDir, err)
+}
+
 // readConfig attempts to read config file, either default `.terraform-docs.yml`
 // or provided file with `-c, --config` flag. It will then attempt to override
 // them with corresponding flags (if set).
@@ -196,6 +359,10 @@ func (r *Runtime) unmarshalConfig(v *viper.Viper, config *print.Config) error {
 		return fmt.Errorf("unable to decode config, %w", err)
 	}
 
+	if file := v.ConfigFileUsed(); file != "" && v.IsSet("plugins.sources") {
+		resolvePluginPaths(config, filepath.Dir(file))
+	}
+
 	// explicitly setting formatter to Config for non-root commands this
 	// will effectively override formatter properties from config file
 	// if 1) config file exists and 2) formatter is set and 3) explicitly
@@ -209,6 +376,20 @@ func (r *Runtime) unmarshalConfig(v *viper.Viper, config *print.Config) error {
 	return nil
 }
 
+// resolvePluginPaths resolves relative paths of 'plugins.sources' of 'config'
+// from 'dir', i.e. the directory of the config file declaring them, for them
+// not to depend on the current directory (e.g. with '-c sub/.terraform-docs.yml'
+// or the config of a submodule in recursive mode).
+func resolvePluginPaths(config *print.Config, dir string) {
+	sources := slices.Clone(config.Plugins.Sources)
+	for i, source := range sources {
+		if !filepath.IsAbs(source.Path) {
+			sources[i].Path = filepath.Join(dir, source.Path)
+		}
+	}
+	config.Plugins.Sources = sources
+}
+
 // bindFlags binds current command's changed flags to viper.
 func (r *Runtime) bindFlags(v *viper.Viper) {
 	sectionsCleared := false
@@ -228,6 +409,12 @@ func (r *Runtime) bindFlags(v *viper.Viper) {
 				sectionsCleared = true
 			}
 
+			items, err := fs.GetStringSlice(f.Name)
+			if err != nil {
+				return
+			}
+			v.Set(flagMappings[f.Name], items)
+		case "example-values-from":
 			items, err := fs.GetStringSlice(f.Name)
 			if err != nil {
 				return
@@ -263,13 +450,29 @@ func (r *Runtime) mergeConfig(v *viper.Viper) (*print.Config, error) {
 // findSubmodules generates list of submodules in `rootDir/RecursivePath` if
 // `--recursive` flag is set. This keeps track of `.terraform-docs.yml` in any
 // of the submodules (if exists) to override the root configuration.
+//
+// If `recursive.include` patterns are provided, submodules are looked up in
+// the whole `rootDir` instead and only the ones matching the patterns are
+// loaded. In both cases directories matching `recursive.exclude` patterns,
+// ignored by `.gitignore` (if `recursive.gitignore` is set) or deeper than
+// `recursive.max-depth` are skipped.
 func (r *Runtime) findSubmodules() ([]module, error) {
 	dir := filepath.Join(r.rootDir, r.config.Recursive.Path)
+	if len(r.config.Recursive.Include) > 0 {
+		dir = r.rootDir
+	}
 
 	if _, err := os.Stat(dir); os.IsNotExist(err) {
 		return nil, err
 	}
 
+	ignore := newGitignore(r.rootDir)
+	if r.config.Recursive.Gitignore {
+		if err := ignore.loadPath(dir); err != nil {
+			return nil, err
+		}
+	}
+
 	modules := []module{}
 
 	err := filepath.WalkDir(dir, func(path string, file os.DirEntry, err error) error {
@@ -281,10 +484,31 @@ func (r *Runtime) findSubmodules() ([]module, error) {
 			return nil
 		}
 
-		if strings.HasPrefix(file.Name(), ".") || slices.Contains(r.config.Recursive.Exclude, file.Name()) {
+		rel, err := relativePath(r.rootDir, path)
+		if err != nil {
+			return err
+		}
+
+		if strings.HasPrefix(file.Name(), ".") || r.isExcluded(rel, file.Name()) || ignore.match(rel, true) {
 			return filepath.SkipDir
 		}
 
+		if maxDepth := r.config.Recursive.MaxDepth; maxDepth > 0 {
+			depth, err := relativePath(dir, path)
+			if err != nil {
+				return err
+			}
+			if strings.Count(depth, "/")+1 > maxDepth {
+				return filepath.SkipDir
+			}
+		}
+
+		if r.config.Recursive.Gitignore {
+			if err := ignore.load(path); err != nil {
+				return err
+			}
+		}
+
 		module, err := r.loadSubModule(path)
 		if err != nil {
 			return err
@@ -304,8 +528,41 @@ func (r *Runtime) findSubmodules() ([]module, error) {
 	return modules, nil
 }
 
+// isExcluded reports whether the directory at 'rel' path (relative to the
+// root module) matches any of `recursive.exclude` patterns. Patterns without
+// a slash are matched against the name of the directory only.
+func (r *Runtime) isExcluded(rel string, name string) bool {
+	for _, pattern := range r.config.Recursive.Exclude {
+		target := rel
+		if !strings.Contains(pattern, "/") {
+			target = name
+		}
+		if matched, _ := doublestar.Match(pattern, target); matched {
+			return true
+		}
+	}
+	return false
+}
+
 // loadSubModule attempts to load a submodule from the given directory path.
+// If `recursive.include` patterns are provided the directory is only loaded
+// if its path, relative to the root module, matches any of them.
 func (r *Runtime) loadSubModule(path string) (*module, error) {
+	if len(r.config.Recursive.Include) > 0 {
+		rel, err := relativePath(r.rootDir, path)
+		if err != nil {
+			return nil, err
+		}
+
+		included := slices.ContainsFunc(r.config.Recursive.Include, func(pattern string) bool {
+			matched, _ := doublestar.Match(pattern, rel)
+			return matched
+		})
+		if !included {
+			return nil, nil
+		}
+	}
+
 	hasTerraformFiles, err := containsTerraformFiles(path)
 	if err != nil {
 		return nil, err
@@ -323,6 +580,15 @@ func (r *Runtime) loadSubModule(path string) (*module, error) {
 	return &module{rootDir: path, config: cfg}, nil
 }
 
+// relativePath returns slash separated path of 'path' relative to 'base'.
+func relativePath(base string, path string) (string, error) {
+	rel, err := filepath.Rel(base, path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(rel), nil
+}
+
 // containsTerraformFiles reports whether a directory contains Terraform files.
 func containsTerraformFiles(path string) (bool, error) {
 	files, err := os.ReadDir(path)
@@ -384,29 +650,18 @@ func checkConstraint(versionRange string, currentVersion string) error {
 	return nil
 }
 
-// generateContent extracts print.Settings and terraform.Options from normalized
-// Config and generates the output content for the module (and submodules if available)
-// and write the result to the output (either stdout or a file).
-func generateContent(config *print.Config) error {
-	module, err := terraform.LoadWithOptions(config)
-	if err != nil {
-		return err
-	}
-
+// generateContent generates the output content for the loaded 'module' based on
+// normalized Config and write the result to the output (either stdout or a file).
+func generateContent(config *print.Config, module *terraform.Module, plugins pluginList, report *checkReport, stdout io.Writer) error {
 	formatter, err := format.New(config)
 
 	// formatter is unknown, this might mean that the intended formatter is
 	// coming from a plugin. We are going to attempt to find a plugin with
 	// that name and generate the content with it or error out if not found.
 	if err != nil {
-		plugins, perr := plugin.Discover()
+		client, perr := plugins.Formatter(config, config.Formatter)
 		if perr != nil {
-			return fmt.Errorf("formatter '%s' not found", config.Formatter)
-		}
-
-		client, found := plugins.Get(config.Formatter)
-		if !found {
-			return fmt.Errorf("formatter '%s' not found", config.Formatter)
+			return perr
 		}
 
 		content, cerr := client.Execute(&pluginsdk.ExecuteArgs{
@@ -417,7 +672,12 @@ func generateContent(config *print.Config) error {
 			return cerr
 		}
 
-		return writeContent(config, content)
+		content, cerr = postProcessContent(config, content, plugins)
+		if cerr != nil {
+			return cerr
+		}
+
+		return writeContent(config, content, nil, report, stdout)
 	}
 
 	err = formatter.Generate(module)
@@ -430,12 +690,19 @@ func generateContent(config *print.Config) error {
 		return err
 	}
 
-	return writeContent(config, content)
+	content, err = postProcessContent(config, content, plugins)
+	if err != nil {
+		return err
+	}
+
+	return writeContent(config, content, formatterSections(formatter), report, stdout)
 }
 
-// writeContent to a Writer. This can either be os.Stdout or specific
-// file (e.g. README.md) if '--output-file' is provided.
-func writeContent(config *print.Config, content string) error {
+// writeContent to a Writer. This can either be 'stdout' or specific
+// file (e.g. README.md) if '--output-file' is provided. The generated
+// 'sections' are used to report which of them are out of date in check
+// mode.
+func writeContent(config *print.Config, content string, sections map[string]string, report *checkReport, stdout io.Writer) error {
 	var w io.Writer
 
 	// writing to a file (either inject or replace)
@@ -446,7 +713,10 @@ func writeContent(config *print.Config, content string) error {
 
 			mode: config.Output.Mode,
 
-			check: config.Output.Check,
+			check:    config.Output.Check,
+			sections: sections,
+			report:   report,
+			stdout:   stdout,
 
 			template: config.Output.Template,
 			begin:    config.Output.BeginComment,
@@ -454,7 +724,7 @@ func writeContent(config *print.Config, content string) error {
 		}
 	} else {
 		// writing to stdout
-		w = &stdoutWriter{}
+		w = &stdoutWriter{out: stdout}
 	}
 
 	_, err := io.WriteString(w, content)
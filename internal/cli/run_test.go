@@ -15,6 +15,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 
@@ -68,6 +69,63 @@ func TestReadConfigAbsolutePath(t *testing.T) {
 	}
 }
 
+func TestReadConfigPluginSources(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "sub")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	absolute := filepath.Join(t.TempDir(), "tfdocs-format-bar")
+	content := "plugins:\n" +
+		"  sources:\n" +
+		"    - name: foo\n" +
+		"      path: ./bin/tfdocs-format-foo\n" +
+		"    - name: bar\n" +
+		"      path: " + absolute + "\n"
+
+	configFile := filepath.Join(dir, ".terraform-docs.yml")
+	if err := os.WriteFile(configFile, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		file        string
+		flagChanged bool
+	}{
+		"ConfigFlag": {
+			file:        configFile,
+			flagChanged: true,
+		},
+		"ModuleConfig": {
+			file:        ".terraform-docs.yml",
+			flagChanged: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			runtime := &Runtime{
+				formatter: "markdown",
+				rootDir:   dir,
+				config:    print.DefaultConfig(),
+				cmd:       &cobra.Command{},
+				isFlagChanged: func(flag string) bool {
+					return flag == "config" && tt.flagChanged
+				},
+			}
+
+			v := viper.New()
+			assert.Nil(runtime.readConfig(v, tt.file, ""))
+			assert.Nil(runtime.unmarshalConfig(v, runtime.config))
+
+			sources := runtime.config.Plugins.Sources
+			assert.Len(sources, 2)
+			assert.Equal(filepath.Join(dir, "bin", "tfdocs-format-foo"), sources[0].Path)
+			assert.Equal(absolute, sources[1].Path)
+		})
+	}
+}
+
 func TestVersionConstraint(t *testing.T) {
 	type tuple struct {
 		constraint string
@@ -146,3 +204,173 @@ func TestVersionConstraint(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateModules(t *testing.T) {
+	dir := t.TempDir()
+
+	modules := []module{}
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(path, 0755); err != nil {
+			t.Fatal(err)
+		}
+		content := "variable \"" + name + "\" {}\n"
+		if name == "c" {
+			content = "variable \"c\" {" // malformed
+		}
+		if err := os.WriteFile(filepath.Join(path, "main.tf"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		modules = append(modules, module{rootDir: path})
+	}
+
+	tests := map[string]struct {
+		parallelism int
+	}{
+		"Sequential": {
+			parallelism: 1,
+		},
+		"Parallel": {
+			parallelism: 4,
+		},
+		"MoreWorkersThanModules": {
+			parallelism: 10,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.DefaultConfig()
+			config.Formatter = "markdown table"
+			config.Recursive.Enabled = true
+			config.Recursive.Parallelism = tt.parallelism
+			config.Output.File = "README.md"
+			config.Output.Mode = print.OutputModeReplace
+
+			runtime := &Runtime{config: config}
+
+			index := 0
+			for result := range runtime.generateModules(modules, false) {
+				path := modules[index].rootDir
+				if filepath.Base(path) == "c" {
+					assert.NotNil(result.err)
+					assert.Contains(result.err.Error(), path+": ")
+				} else {
+					assert.Nil(result.err)
+					assert.Equal(filepath.Join(path, "README.md")+" updated successfully\n", result.stdout.String())
+				}
+				index++
+			}
+			assert.Equal(len(modules), index)
+		})
+	}
+}
+
+func TestFindSubmodules(t *testing.T) {
+	root := t.TempDir()
+	for _, dir := range []string{
+		"modules/a",
+		"modules/b",
+		"modules/b/nested",
+		"modules/b/legacy",
+		"modules/test-c",
+		"modules/vendored",
+		"examples/basic",
+		"examples/complete/modules/d",
+		"docs",
+	} {
+		path := filepath.Join(root, dir)
+		if err := os.MkdirAll(path, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if dir == "docs" {
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(path, "main.tf"), []byte(""), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(root, ".gitignore"), []byte("vendored/\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "modules", ".gitignore"), []byte("legacy/\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		recursive func(c *print.Config)
+		expected  []string
+	}{
+		"Path": {
+			recursive: func(c *print.Config) {},
+			expected:  []string{"modules/a", "modules/b", "modules/b/nested", "modules/test-c"},
+		},
+		"WithoutGitignore": {
+			recursive: func(c *print.Config) {
+				c.Recursive.Gitignore = false
+			},
+			expected: []string{"modules/a", "modules/b", "modules/b/legacy", "modules/b/nested", "modules/test-c", "modules/vendored"},
+		},
+		"GitignoreAbovePath": {
+			recursive: func(c *print.Config) {
+				c.Recursive.Path = "modules/b"
+			},
+			expected: []string{"modules/b/nested"},
+		},
+		"ExcludeName": {
+			recursive: func(c *print.Config) {
+				c.Recursive.Exclude = []string{"b"}
+			},
+			expected: []string{"modules/a", "modules/test-c"},
+		},
+		"ExcludeGlob": {
+			recursive: func(c *print.Config) {
+				c.Recursive.Exclude = []string{"test-*", "modules/b/*"}
+			},
+			expected: []string{"modules/a", "modules/b"},
+		},
+		"MaxDepth": {
+			recursive: func(c *print.Config) {
+				c.Recursive.MaxDepth = 1
+			},
+			expected: []string{"modules/a", "modules/b", "modules/test-c"},
+		},
+		"Include": {
+			recursive: func(c *print.Config) {
+				c.Recursive.Include = []string{"modules/*", "examples/**"}
+			},
+			expected: []string{"examples/basic", "examples/complete/modules/d", "modules/a", "modules/b", "modules/test-c"},
+		},
+		"IncludeWithExcludeAndMaxDepth": {
+			recursive: func(c *print.Config) {
+				c.Recursive.Include = []string{"**"}
+				c.Recursive.Exclude = []string{"examples"}
+				c.Recursive.MaxDepth = 2
+			},
+			expected: []string{"modules/a", "modules/b", "modules/test-c"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.DefaultConfig()
+			config.File = ".terraform-docs.yml"
+			config.Recursive.Enabled = true
+			tt.recursive(config)
+
+			runtime := &Runtime{rootDir: root, config: config}
+
+			modules, err := runtime.findSubmodules()
+			assert.Nil(err)
+
+			actual := []string{}
+			for _, m := range modules {
+				rel, _ := relativePath(root, m.rootDir)
+				actual = append(actual, rel)
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
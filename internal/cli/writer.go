@@ -15,6 +15,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
@@ -23,12 +24,17 @@ import (
 	"github.com/terraform-docs/terraform-docs/print"
 )
 
-// stdoutWriter writes content to os.Stdout.
-type stdoutWriter struct{}
+// stdoutWriter writes content to 'out', or os.Stdout if it's not set.
+type stdoutWriter struct {
+	out io.Writer
+}
 
 // Write content to Stdout
 func (sw *stdoutWriter) Write(p []byte) (int, error) {
-	return os.Stdout.WriteString(string(p) + "\n")
+	if sw.out == nil {
+		return os.Stdout.WriteString(string(p) + "\n")
+	}
+	return io.WriteString(sw.out, string(p)+"\n")
 }
 
 // fileWriter writes content to file.
@@ -49,7 +55,10 @@ type fileWriter struct {
 
 	mode string
 
-	check bool
+	check    bool
+	sections map[string]string
+	report   *checkReport
+	stdout   io.Writer
 
 	template string
 	begin    string
@@ -161,24 +170,56 @@ func (fw *fileWriter) inject(filename string, content string, generated string)
 func (fw *fileWriter) write(filename string, p []byte) (int, error) {
 	// if run in check mode return exit 1
 	if fw.check {
-		f, err := os.ReadFile(filepath.Clean(filename))
-		if err != nil {
-			return 0, err
-		}
-
-		// check for changes and print changed file
-		if !bytes.Equal(f, p) {
-			return 0, fmt.Errorf("%s is out of date", filename)
-		}
-
-		fmt.Printf("%s is up to date\n", filename)
-		return 0, nil
+		return fw.compare(filename, p)
 	}
 
 	if fw.writer != nil {
 		return fw.writer.Write(p)
 	}
 
-	fmt.Printf("%s updated successfully\n", filename)
+	fw.printf("%s updated successfully\n", filename)
 	return len(p), os.WriteFile(filename, p, 0644)
 }
+
+// compare the content of 'filename' with the generated content, in check mode.
+// If they differ the unified diff is printed and an error is returned. The
+// result is also added to the check report, if any.
+func (fw *fileWriter) compare(filename string, p []byte) (int, error) {
+	// a missing file is out of date, as if it was empty
+	f, err := os.ReadFile(filepath.Clean(filename))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return 0, err
+	}
+
+	result := &checkResult{
+		File:     filename,
+		Module:   fw.dir,
+		UpToDate: bytes.Equal(f, p),
+		Sections: []string{},
+	}
+
+	if !result.UpToDate {
+		result.Diff = unifiedDiff(filename, string(f), string(p))
+		result.Sections = changedSections(string(f), string(p), fw.sections)
+	}
+
+	fw.report.add(result)
+
+	// check for changes and print changed file
+	if !result.UpToDate {
+		fw.printf("%s", result.Diff)
+		return 0, &outOfDateError{file: filename}
+	}
+
+	fw.printf("%s is up to date\n", filename)
+	return 0, nil
+}
+
+// printf formats and prints messages to 'stdout', or os.Stdout if it's not set.
+func (fw *fileWriter) printf(format string, a ...interface{}) {
+	if fw.stdout == nil {
+		fmt.Printf(format, a...)
+		return
+	}
+	fmt.Fprintf(fw.stdout, format, a...)
+}
@@ -0,0 +1,330 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+// Kind of a change.
+type Kind string
+
+// Kinds of changes.
+const (
+	Added   Kind = "added"
+	Removed Kind = "removed"
+	Renamed Kind = "renamed"
+	Changed Kind = "changed"
+)
+
+// Item is the type of the changed item of the module.
+type Item string
+
+// Types of changed items.
+const (
+	Input       Item = "input"
+	Output      Item = "output"
+	Requirement Item = "requirement"
+)
+
+// Fields of the changed items.
+const (
+	FieldDefault  = "default"
+	FieldRequired = "required"
+	FieldType     = "type"
+	FieldVersion  = "version"
+)
+
+// Change represents a single change between two versions of a module, from
+// the point of view of its consumers.
+type Change struct {
+	Kind     Kind   `json:"kind"`
+	Item     Item   `json:"item"`
+	Name     string `json:"name"`
+	OldName  string `json:"old_name,omitempty"`
+	Field    string `json:"field,omitempty"`
+	Old      string `json:"old,omitempty"`
+	New      string `json:"new,omitempty"`
+	Breaking bool   `json:"breaking"`
+}
+
+// String returns the human readable description of the change.
+func (c *Change) String() string {
+	label := strings.ToUpper(string(c.Item[:1])) + string(c.Item[1:])
+	switch c.Kind {
+	case Added:
+		if c.Item == Input && c.Breaking {
+			return fmt.Sprintf("%s `%s` was added as required.", label, c.Name)
+		}
+		if c.New != "" {
+			return fmt.Sprintf("%s `%s` was added with %s.", label, c.Name, quote(c.New))
+		}
+		return fmt.Sprintf("%s `%s` was added.", label, c.Name)
+	case Removed:
+		return fmt.Sprintf("%s `%s` was removed.", label, c.Name)
+	case Renamed:
+		return fmt.Sprintf("%s `%s` was renamed to `%s`.", label, c.OldName, c.Name)
+	}
+
+	switch c.Field {
+	case FieldRequired:
+		if c.New == "true" {
+			return fmt.Sprintf("%s `%s` became required.", label, c.Name)
+		}
+		return fmt.Sprintf("%s `%s` became optional.", label, c.Name)
+	case FieldVersion:
+		return fmt.Sprintf("Version constraint of %s `%s` changed from %s to %s.", c.Item, c.Name, quote(c.Old), quote(c.New))
+	}
+	return fmt.Sprintf("%s of %s `%s` changed from %s to %s.", strings.ToUpper(c.Field[:1])+c.Field[1:], c.Item, c.Name, quote(c.Old), quote(c.New))
+}
+
+func quote(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return "`" + s + "`"
+}
+
+// Report represents all the changes between two versions of a module.
+type Report struct {
+	Breaking bool      `json:"breaking"`
+	Changes  []*Change `json:"changes"`
+}
+
+// BreakingChanges returns the changes which are breaking.
+func (r *Report) BreakingChanges() []*Change {
+	return r.filter(true)
+}
+
+// NonBreakingChanges returns the changes which aren't breaking.
+func (r *Report) NonBreakingChanges() []*Change {
+	return r.filter(false)
+}
+
+func (r *Report) filter(breaking bool) []*Change {
+	changes := make([]*Change, 0)
+	for _, c := range r.Changes {
+		if c.Breaking == breaking {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+func (r *Report) add(c *Change) {
+	r.Changes = append(r.Changes, c)
+	r.Breaking = r.Breaking || c.Breaking
+}
+
+// Compare 'old' and 'new' versions of a module and returns the report of
+// changes of its inputs, outputs and requirements. The changes are classified
+// as breaking, if consumers of the module may need to update how they call it.
+func Compare(old *terraform.Module, new *terraform.Module) *Report {
+	report := &Report{Changes: make([]*Change, 0)}
+	compareInputs(report, old.Inputs, new.Inputs)
+	compareOutputs(report, old.Outputs, new.Outputs)
+	compareRequirements(report, old.Requirements, new.Requirements)
+	return report
+}
+
+func compareInputs(report *Report, old []*terraform.Input, new []*terraform.Input) {
+	oldInputs := make(map[string]*terraform.Input)
+	for _, i := range old {
+		oldInputs[i.Name] = i
+	}
+	newInputs := make(map[string]*terraform.Input)
+	for _, i := range new {
+		newInputs[i.Name] = i
+	}
+
+	removed := make([]*terraform.Input, 0)
+	for _, o := range old {
+		n, ok := newInputs[o.Name]
+		if !ok {
+			removed = append(removed, o)
+			continue
+		}
+		compareInput(report, o, n)
+	}
+
+	added := make([]*terraform.Input, 0)
+	for _, n := range new {
+		if _, ok := oldInputs[n.Name]; !ok {
+			added = append(added, n)
+		}
+	}
+
+	// an input removed and another one added with identical description, type
+	// and default value is considered renamed
+	for _, o := range removed {
+		index := -1
+		for j, n := range added {
+			if o.Description != "" && o.Description == n.Description && o.Type == n.Type && o.Required == n.Required && o.GetValue() == n.GetValue() {
+				index = j
+				break
+			}
+		}
+		if index == -1 {
+			report.add(&Change{Kind: Removed, Item: Input, Name: o.Name, Breaking: true})
+			continue
+		}
+		report.add(&Change{Kind: Renamed, Item: Input, Name: added[index].Name, OldName: o.Name, Breaking: true})
+		added = append(added[:index], added[index+1:]...)
+	}
+
+	for _, n := range added {
+		report.add(&Change{Kind: Added, Item: Input, Name: n.Name, Breaking: n.Required})
+	}
+}
+
+func compareInput(report *Report, old *terraform.Input, new *terraform.Input) {
+	if old.Type != new.Type {
+		// widening the type to 'any' doesn't reject any value
+		widened := new.Type == "" || new.Type == "any"
+		report.add(&Change{
+			Kind:     Changed,
+			Item:     Input,
+			Name:     new.Name,
+			Field:    FieldType,
+			Old:      string(old.Type),
+			New:      string(new.Type),
+			Breaking: !widened,
+		})
+	}
+	if old.Required != new.Required {
+		report.add(&Change{
+			Kind:     Changed,
+			Item:     Input,
+			Name:     new.Name,
+			Field:    FieldRequired,
+			Old:      fmt.Sprint(old.Required),
+			New:      fmt.Sprint(new.Required),
+			Breaking: new.Required,
+		})
+		return
+	}
+	if !new.Required && old.GetValue() != new.GetValue() {
+		report.add(&Change{
+			Kind:     Changed,
+			Item:     Input,
+			Name:     new.Name,
+			Field:    FieldDefault,
+			Old:      old.GetValue(),
+			New:      new.GetValue(),
+			Breaking: false,
+		})
+	}
+}
+
+func compareOutputs(report *Report, old []*terraform.Output, new []*terraform.Output) {
+	oldOutputs := make(map[string]*terraform.Output)
+	for _, o := range old {
+		oldOutputs[o.Name] = o
+	}
+	newOutputs := make(map[string]*terraform.Output)
+	for _, o := range new {
+		newOutputs[o.Name] = o
+	}
+
+	removed := make([]*terraform.Output, 0)
+	for _, o := range old {
+		if _, ok := newOutputs[o.Name]; !ok {
+			removed = append(removed, o)
+		}
+	}
+
+	added := make([]*terraform.Output, 0)
+	for _, n := range new {
+		if _, ok := oldOutputs[n.Name]; !ok {
+			added = append(added, n)
+		}
+	}
+
+	// an output removed and another one added with identical description is
+	// considered renamed
+	for _, o := range removed {
+		index := -1
+		for j, n := range added {
+			if o.Description != "" && o.Description == n.Description {
+				index = j
+				break
+			}
+		}
+		if index == -1 {
+			report.add(&Change{Kind: Removed, Item: Output, Name: o.Name, Breaking: true})
+			continue
+		}
+		report.add(&Change{Kind: Renamed, Item: Output, Name: added[index].Name, OldName: o.Name, Breaking: true})
+		added = append(added[:index], added[index+1:]...)
+	}
+
+	for _, n := range added {
+		report.add(&Change{Kind: Added, Item: Output, Name: n.Name, Breaking: false})
+	}
+}
+
+// compareRequirements compares the version constraints of Terraform and the
+// providers. Any new or changed constraint is conservatively considered
+// breaking, as it may conflict with the constraints of the consumers, unless
+// the constraint is dropped.
+func compareRequirements(report *Report, old []*terraform.Requirement, new []*terraform.Requirement) {
+	oldNames, oldVersions := groupRequirements(old)
+	newNames, newVersions := groupRequirements(new)
+
+	for _, name := range oldNames {
+		n, ok := newVersions[name]
+		if !ok {
+			report.add(&Change{Kind: Removed, Item: Requirement, Name: name, Breaking: false})
+			continue
+		}
+		if o := oldVersions[name]; o != n {
+			report.add(&Change{
+				Kind:     Changed,
+				Item:     Requirement,
+				Name:     name,
+				Field:    FieldVersion,
+				Old:      o,
+				New:      n,
+				Breaking: n != "",
+			})
+		}
+	}
+
+	for _, name := range newNames {
+		if _, ok := oldVersions[name]; !ok {
+			report.add(&Change{Kind: Added, Item: Requirement, Name: name, New: newVersions[name], Breaking: newVersions[name] != ""})
+		}
+	}
+}
+
+// groupRequirements returns the names of 'requirements', in order, and their
+// version constraints joined together, keyed by their names.
+func groupRequirements(requirements []*terraform.Requirement) ([]string, map[string]string) {
+	names := make([]string, 0)
+	versions := make(map[string]string)
+	for _, r := range requirements {
+		version := strings.TrimSpace(string(r.Version))
+		existing, ok := versions[r.Name]
+		switch {
+		case !ok:
+			names = append(names, r.Name)
+			versions[r.Name] = version
+		case version != "" && existing != "":
+			versions[r.Name] = existing + ", " + version
+		case version != "":
+			versions[r.Name] = version
+		}
+	}
+	return names, versions
+}
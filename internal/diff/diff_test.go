@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package diff
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+func loadModule(t *testing.T, path string) *terraform.Module {
+	config := print.DefaultConfig()
+	config.ModuleRoot = filepath.Join("testdata", path)
+	config.Sections.Header = false
+
+	module, err := terraform.LoadWithOptions(config)
+	assert.Nil(t, err)
+
+	return module
+}
+
+func readExpected(t *testing.T, name string) string {
+	content, err := os.ReadFile(filepath.Join("testdata", name))
+	assert.Nil(t, err)
+
+	return string(content)
+}
+
+func TestCompare(t *testing.T) {
+	assert := assert.New(t)
+
+	report := Compare(loadModule(t, "old"), loadModule(t, "new"))
+
+	assert.True(report.Breaking)
+	assert.Len(report.BreakingChanges(), 9)
+	assert.Len(report.NonBreakingChanges(), 5)
+
+	actual, err := report.Render(FormatMarkdown)
+	assert.Nil(err)
+	assert.Equal(readExpected(t, "report.md"), actual+"\n")
+
+	actual, err = report.Render(FormatJSON)
+	assert.Nil(err)
+	assert.Equal(readExpected(t, "report.json"), actual+"\n")
+}
+
+func TestCompareIdentical(t *testing.T) {
+	assert := assert.New(t)
+
+	report := Compare(loadModule(t, "old"), loadModule(t, "old"))
+
+	assert.False(report.Breaking)
+	assert.Empty(report.Changes)
+	assert.Equal("No changes.", report.Markdown())
+}
+
+func TestChangeString(t *testing.T) {
+	tests := map[string]struct {
+		change   *Change
+		expected string
+	}{
+		"AddedOptionalInput": {
+			change:   &Change{Kind: Added, Item: Input, Name: "foo"},
+			expected: "Input `foo` was added.",
+		},
+		"AddedRequiredInput": {
+			change:   &Change{Kind: Added, Item: Input, Name: "foo", Breaking: true},
+			expected: "Input `foo` was added as required.",
+		},
+		"AddedRequirement": {
+			change:   &Change{Kind: Added, Item: Requirement, Name: "aws", New: ">= 5.0", Breaking: true},
+			expected: "Requirement `aws` was added with `>= 5.0`.",
+		},
+		"RemovedOutput": {
+			change:   &Change{Kind: Removed, Item: Output, Name: "foo", Breaking: true},
+			expected: "Output `foo` was removed.",
+		},
+		"RenamedOutput": {
+			change:   &Change{Kind: Renamed, Item: Output, Name: "bar", OldName: "foo", Breaking: true},
+			expected: "Output `foo` was renamed to `bar`.",
+		},
+		"BecameRequired": {
+			change:   &Change{Kind: Changed, Item: Input, Name: "foo", Field: FieldRequired, Old: "false", New: "true"},
+			expected: "Input `foo` became required.",
+		},
+		"BecameOptional": {
+			change:   &Change{Kind: Changed, Item: Input, Name: "foo", Field: FieldRequired, Old: "true", New: "false"},
+			expected: "Input `foo` became optional.",
+		},
+		"ChangedDefault": {
+			change:   &Change{Kind: Changed, Item: Input, Name: "foo", Field: FieldDefault, Old: "null", New: "1"},
+			expected: "Default of input `foo` changed from `null` to `1`.",
+		},
+		"DroppedVersion": {
+			change:   &Change{Kind: Changed, Item: Requirement, Name: "aws", Field: FieldVersion, Old: ">= 4.0"},
+			expected: "Version constraint of requirement `aws` changed from `>= 4.0` to none.",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, tt.change.String())
+		})
+	}
+}
+
+func TestRenderUnsupportedFormat(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := (&Report{}).Render("yaml")
+	assert.EqualError(err, "format 'yaml' is not supported, must be one of: json, markdown")
+}
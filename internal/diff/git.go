@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package diff
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Checkout extracts the content of the module in 'path', as of git reference
+// 'ref' (e.g. a tag or a commit) of the repository it belongs to, into a
+// temporary directory. It returns the path of the module in that directory,
+// and a function to remove it when done.
+func Checkout(ref string, path string) (string, func(), error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", nil, err
+	}
+
+	root, err := git(abs, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", nil, err
+	}
+	root = strings.TrimSpace(root)
+
+	// resolve symlinks on both sides, e.g. /tmp on macOS
+	if resolved, err := filepath.EvalSymlinks(abs); err == nil {
+		abs = resolved
+	}
+	if resolved, err := filepath.EvalSymlinks(root); err == nil {
+		root = resolved
+	}
+
+	rel, err := filepath.Rel(root, abs)
+	if err != nil {
+		return "", nil, err
+	}
+	rel = filepath.ToSlash(rel)
+
+	args := []string{"archive", "--format=tar", ref}
+	if rel != "." {
+		args = append(args, "--", rel)
+	}
+	archive, err := git(root, args...)
+	if err != nil {
+		return "", nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "terraform-docs-diff-")
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup := func() {
+		os.RemoveAll(dir) //nolint:errcheck,gosec
+	}
+
+	if err := untar(archive, dir); err != nil {
+		cleanup()
+		return "", nil, err
+	}
+
+	return filepath.Join(dir, filepath.FromSlash(rel)), cleanup, nil
+}
+
+func git(dir string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(context.TODO(), "git", args...)
+	cmd.Dir = dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git %s: %s", args[0], msg)
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return stdout.String(), nil
+}
+
+// untar extracts regular files and directories of tar 'archive' into 'dir'.
+func untar(archive string, dir string) error {
+	reader := tar.NewReader(strings.NewReader(archive))
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(header.Name)) //nolint:gosec
+		if !strings.HasPrefix(target, filepath.Clean(dir)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid path in archive: %s", header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			content, err := io.ReadAll(reader) //nolint:gosec
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(target, content, 0644); err != nil { //nolint:gosec
+				return err
+			}
+		}
+	}
+}
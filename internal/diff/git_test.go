@@ -0,0 +1,56 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package diff
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckout(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+
+	assert := assert.New(t)
+
+	root := t.TempDir()
+	module := filepath.Join(root, "modules", "foo")
+	assert.Nil(os.MkdirAll(module, 0755))
+	assert.Nil(os.WriteFile(filepath.Join(module, "main.tf"), []byte("variable \"old\" {}\n"), 0644))
+
+	for _, args := range [][]string{
+		{"init", "--quiet"},
+		{"add", "."},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "-m", "initial"},
+		{"tag", "v1.0.0"},
+	} {
+		_, err := git(root, args...)
+		assert.Nil(err)
+	}
+
+	assert.Nil(os.WriteFile(filepath.Join(module, "main.tf"), []byte("variable \"new\" {}\n"), 0644))
+
+	path, cleanup, err := Checkout("v1.0.0", module)
+	assert.Nil(err)
+	defer cleanup()
+
+	content, err := os.ReadFile(filepath.Join(path, "main.tf"))
+	assert.Nil(err)
+	assert.Equal("variable \"old\" {}\n", string(content))
+
+	_, _, err = Checkout("v2.0.0", module)
+	assert.NotNil(err)
+}
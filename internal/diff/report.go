@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Formats of the report.
+const (
+	FormatJSON     = "json"
+	FormatMarkdown = "markdown"
+)
+
+// Formats is the list of supported formats of the report.
+var Formats = []string{FormatJSON, FormatMarkdown}
+
+// Render the report in 'format', which is one of 'Formats'.
+func (r *Report) Render(format string) (string, error) {
+	switch format {
+	case FormatJSON:
+		return r.JSON()
+	case FormatMarkdown:
+		return r.Markdown(), nil
+	}
+	return "", fmt.Errorf("format '%s' is not supported, must be one of: %s", format, strings.Join(Formats, ", "))
+}
+
+// JSON returns the report as JSON document.
+func (r *Report) JSON() (string, error) {
+	buffer := new(bytes.Buffer)
+	encoder := json.NewEncoder(buffer)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(r); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
+
+// Markdown returns the report as Markdown document, with breaking changes
+// listed first.
+func (r *Report) Markdown() string {
+	if len(r.Changes) == 0 {
+		return "No changes."
+	}
+
+	var sections []string
+	if changes := r.BreakingChanges(); len(changes) > 0 {
+		sections = append(sections, markdownSection("Breaking changes", changes))
+	}
+	if changes := r.NonBreakingChanges(); len(changes) > 0 {
+		sections = append(sections, markdownSection("Non-breaking changes", changes))
+	}
+	return strings.Join(sections, "\n\n")
+}
+
+func markdownSection(title string, changes []*Change) string {
+	var b strings.Builder
+	b.WriteString("## " + title + "\n\n")
+	for i, c := range changes {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("- " + c.String())
+	}
+	return b.String()
+}
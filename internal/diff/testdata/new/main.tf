@@ -0,0 +1,68 @@
+terraform {
+  required_version = ">= 1.3"
+
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = ">= 4.0"
+    }
+    tls = {
+      source  = "hashicorp/tls"
+      version = ">= 4.0"
+    }
+  }
+}
+
+variable "name" {
+  description = "Name of the resource."
+  type        = string
+}
+
+variable "instance_type" {
+  description = "Type of the instance."
+  type        = string
+  default     = "t3.small"
+}
+
+variable "availability_zone" {
+  description = "Availability zone."
+  type        = string
+  default     = ""
+}
+
+variable "tags" {
+  description = "Tags to attach."
+  type        = any
+  default     = {}
+}
+
+variable "ports" {
+  description = "Ports to open."
+  type        = set(number)
+}
+
+variable "subnet_id" {
+  description = "ID of the subnet."
+  type        = string
+}
+
+variable "monitoring" {
+  description = "Whether to enable monitoring."
+  type        = bool
+  default     = false
+}
+
+output "id" {
+  description = "ID of the instance."
+  value       = "id"
+}
+
+output "private_address" {
+  description = "Address of the instance."
+  value       = "address"
+}
+
+output "public_ip" {
+  description = "Public IP of the instance."
+  value       = "ip"
+}
@@ -0,0 +1,64 @@
+terraform {
+  required_version = ">= 1.0"
+
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = ">= 4.0"
+    }
+    random = {
+      source  = "hashicorp/random"
+      version = ">= 3.0"
+    }
+  }
+}
+
+variable "name" {
+  description = "Name of the resource."
+  type        = string
+}
+
+variable "instance_type" {
+  description = "Type of the instance."
+  type        = string
+  default     = "t3.micro"
+}
+
+variable "zone" {
+  description = "Availability zone."
+  type        = string
+  default     = ""
+}
+
+variable "tags" {
+  description = "Tags to attach."
+  type        = map(string)
+  default     = {}
+}
+
+variable "ports" {
+  description = "Ports to open."
+  type        = list(number)
+  default     = []
+}
+
+variable "legacy" {
+  description = "Not used anymore."
+  type        = bool
+  default     = false
+}
+
+output "id" {
+  description = "ID of the instance."
+  value       = "id"
+}
+
+output "address" {
+  description = "Address of the instance."
+  value       = "address"
+}
+
+output "arn" {
+  description = "ARN of the instance."
+  value       = "arn"
+}
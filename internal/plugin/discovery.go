@@ -11,108 +11,189 @@ the root directory of this source tree.
 package plugin
 
 import (
-	"context"
-	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"runtime"
+	"slices"
+	"sort"
 	"strings"
 
-	goplugin "github.com/hashicorp/go-plugin"
 	"github.com/mitchellh/go-homedir"
 
-	pluginsdk "github.com/terraform-docs/terraform-docs/plugin"
+	"github.com/terraform-docs/terraform-docs/print"
 )
 
-// Discover plugins and registers them. The lookup priority of plugins is as
-// follow:
-//
-// 1. `TFDOCS_PLUGIN_DIR` environment variable (if it's set)
-// 2. Current directory (./.tfdocs.d/plugins)
-// 3. Home directory (~/.tfdocs.d/plugins)
-//
-// Files under these directories that satisfy the "tfdocs-format-*" naming
-// convention are treated as plugins.
-func Discover() (*List, error) {
+// Sources of plugins.
+const (
+	SourceConfig = "config"
+	SourceEnv    = "env"
+	SourceLocal  = "local"
+	SourceHome   = "home"
+)
+
+// Plugin represents a plugin executable, either found in one of the plugins
+// directories or explicitly declared in 'plugins.sources' of config. Plugins
+// are not launched until they're used.
+type Plugin struct {
+	Name     string
+	Path     string
+	Checksum string
+	Source   string
+}
+
+// lookupDir is a directory plugins are looked up in.
+type lookupDir struct {
+	path   string
+	source string
+}
+
+// lookupDirs returns the directories plugins are looked up in, by priority.
+func lookupDirs() ([]lookupDir, error) {
+	dirs := []lookupDir{}
+
 	if dir := os.Getenv("TFDOCS_PLUGIN_DIR"); dir != "" {
-		return findPlugins(dir)
+		dirs = append(dirs, lookupDir{path: dir, source: SourceEnv})
 	}
 
-	if _, err := os.Stat(localPluginsRoot); !os.IsNotExist(err) {
-		return findPlugins(localPluginsRoot)
-	}
+	dirs = append(dirs, lookupDir{path: localPluginsRoot, source: SourceLocal})
 
 	dir, err := homedir.Expand(homePluginsRoot)
 	if err != nil {
 		return nil, err
 	}
 
-	return findPlugins(dir)
-}
+	dirs = append(dirs, lookupDir{path: dir, source: SourceHome})
 
-// findPlugins finds plugins in a given 'dir' and registers them.
-func findPlugins(dir string) (*List, error) {
-	clients := map[string]*goplugin.Client{}
-	formatters := map[string]*pluginsdk.Client{}
+	return dirs, nil
+}
 
-	files, err := os.ReadDir(dir)
+// Discover finds plugins in all the plugins directories, without launching
+// them. The lookup priority of plugins is as follow:
+//
+// 1. `TFDOCS_PLUGIN_DIR` environment variable (if it's set)
+// 2. Current directory (./.tfdocs.d/plugins)
+// 3. Home directory (~/.tfdocs.d/plugins)
+//
+// Files under these directories that satisfy the "tfdocs-format-*" naming
+// convention are treated as plugins. If a plugin with the same name is found
+// in more than one directory, the one with higher priority is used. Missing
+// directories are skipped.
+func Discover() ([]*Plugin, error) {
+	dirs, err := lookupDirs()
 	if err != nil {
 		return nil, err
 	}
 
-	for _, f := range files {
-		name := strings.ReplaceAll(f.Name(), namePrefix, "")
-		path, err := getPluginPath(dir, name)
+	found := map[string]bool{}
+	plugins := []*Plugin{}
+
+	for _, dir := range dirs {
+		items, err := findPlugins(dir)
 		if err != nil {
 			return nil, err
 		}
 
-		// Accepting variables here is intentional; we need to determine the
-		// path on the fly per directory.
-		//
-		// nolint:gosec
-		cmd := exec.CommandContext(context.TODO(), path)
+		for _, p := range items {
+			if found[p.Name] {
+				continue
+			}
+			found[p.Name] = true
+			plugins = append(plugins, p)
+		}
+	}
+
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].Name < plugins[j].Name
+	})
 
-		client := pluginsdk.NewClient(&pluginsdk.ClientOpts{
-			Cmd: cmd,
+	return plugins, nil
+}
+
+// Declared returns the plugins explicitly declared in 'plugins.sources' of
+// 'config'. Relative paths are already resolved from the directory of the
+// config file declaring them when the config is read.
+func Declared(config *print.Config) []*Plugin {
+	plugins := []*Plugin{}
+	if config == nil {
+		return plugins
+	}
+	for _, source := range config.Plugins.Sources {
+		plugins = append(plugins, &Plugin{
+			Name:     source.Name,
+			Path:     source.Path,
+			Checksum: source.Checksum,
+			Source:   SourceConfig,
 		})
+	}
+	return plugins
+}
 
-		rpcClient, err := client.Client()
-		if err != nil {
-			return nil, err
+// All returns plugins declared in 'config' and the ones found in plugins
+// directories, sorted by name. Declared plugins shadow the found ones with
+// the same name.
+func All(config *print.Config) ([]*Plugin, error) {
+	plugins := Declared(config)
+
+	found, err := Discover()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range found {
+		if !slices.ContainsFunc(plugins, func(d *Plugin) bool { return d.Name == p.Name }) {
+			plugins = append(plugins, p)
 		}
+	}
 
-		raw, err := rpcClient.Dispense("formatter")
-		if err != nil {
-			return nil, err
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].Name < plugins[j].Name
+	})
+
+	return plugins, nil
+}
+
+// findPlugins finds plugins in a given 'dir'.
+func findPlugins(dir lookupDir) ([]*Plugin, error) {
+	files, err := os.ReadDir(dir.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
 		}
+		return nil, err
+	}
 
-		formatter := raw.(*pluginsdk.Client)
+	plugins := []*Plugin{}
 
-		if _, ok := clients[name]; ok {
-			return nil, fmt.Errorf("plugin %s is already registered", name)
+	for _, f := range files {
+		name, ok := pluginName(f.Name())
+		if !ok || f.IsDir() {
+			continue
 		}
 
-		clients[name] = client
-		formatters[name] = formatter
+		plugins = append(plugins, &Plugin{
+			Name:   name,
+			Path:   filepath.Join(dir.path, f.Name()),
+			Source: dir.source,
+		})
 	}
 
-	return &List{formatters: formatters, clients: clients}, nil
+	return plugins, nil
 }
 
-func getPluginPath(dir string, name string) (string, error) {
-	suffix := ""
-
+// pluginName returns the name of the plugin from its file name, and false if
+// the file name doesn't satisfy the naming convention of plugins.
+func pluginName(filename string) (string, bool) {
 	if runtime.GOOS == "windows" {
-		suffix += ".exe"
+		if !strings.HasSuffix(filename, ".exe") {
+			return "", false
+		}
+		filename = strings.TrimSuffix(filename, ".exe")
 	}
 
-	path := filepath.Join(dir, fmt.Sprintf("%s%s%s", namePrefix, name, suffix))
-
-	if _, err := os.Stat(path); os.IsNotExist(err) {
-		return "", os.ErrNotExist
+	name, ok := strings.CutPrefix(filename, namePrefix)
+	if !ok || name == "" {
+		return "", false
 	}
 
-	return path, nil
+	return name, true
 }
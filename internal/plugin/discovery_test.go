@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+// withPluginsDirs creates 'files' in temporary env, local and home plugins
+// directories, and returns their paths.
+func withPluginsDirs(t *testing.T, files map[string][]string) map[string]string {
+	suffix := ""
+	if runtime.GOOS == "windows" {
+		suffix = ".exe"
+	}
+
+	dirs := map[string]string{}
+	for _, source := range []string{SourceEnv, SourceLocal, SourceHome} {
+		dirs[source] = filepath.Join(t.TempDir(), "plugins")
+		if err := os.MkdirAll(filepath.Join(dirs[source], "tfdocs-format-dir"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range files[source] {
+			if err := os.WriteFile(filepath.Join(dirs[source], name+suffix), []byte{}, 0755); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	t.Setenv("TFDOCS_PLUGIN_DIR", dirs[SourceEnv])
+
+	local, home := localPluginsRoot, homePluginsRoot
+	localPluginsRoot, homePluginsRoot = dirs[SourceLocal], dirs[SourceHome]
+	t.Cleanup(func() { localPluginsRoot, homePluginsRoot = local, home })
+
+	return dirs
+}
+
+func TestDiscover(t *testing.T) {
+	assert := assert.New(t)
+
+	dirs := withPluginsDirs(t, map[string][]string{
+		SourceEnv:   {"tfdocs-format-foo"},
+		SourceLocal: {"tfdocs-format-bar", "tfdocs-format-foo", "README.md"},
+		SourceHome:  {"tfdocs-format-baz", "tfdocs-format-bar", "tfdocs-format-"},
+	})
+
+	plugins, err := Discover()
+
+	assert.Nil(err)
+	assert.Equal([]*Plugin{
+		{Name: "bar", Path: filepath.Join(dirs[SourceLocal], "tfdocs-format-bar"), Source: SourceLocal},
+		{Name: "baz", Path: filepath.Join(dirs[SourceHome], "tfdocs-format-baz"), Source: SourceHome},
+		{Name: "foo", Path: filepath.Join(dirs[SourceEnv], "tfdocs-format-foo"), Source: SourceEnv},
+	}, withoutSuffix(plugins))
+}
+
+func TestDiscoverMissingDirs(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Setenv("TFDOCS_PLUGIN_DIR", filepath.Join(t.TempDir(), "missing"))
+
+	local, home := localPluginsRoot, homePluginsRoot
+	localPluginsRoot, homePluginsRoot = filepath.Join(t.TempDir(), "missing"), filepath.Join(t.TempDir(), "missing")
+	t.Cleanup(func() { localPluginsRoot, homePluginsRoot = local, home })
+
+	plugins, err := Discover()
+
+	assert.Nil(err)
+	assert.Empty(plugins)
+}
+
+func TestAll(t *testing.T) {
+	assert := assert.New(t)
+
+	dirs := withPluginsDirs(t, map[string][]string{
+		SourceLocal: {"tfdocs-format-bar", "tfdocs-format-foo"},
+	})
+
+	root := t.TempDir()
+	content := `formatter: foo
+sort:
+  by: name
+plugins:
+  sources:
+    - name: foo
+      path: bin/tfdocs-format-foo
+      checksum: "` + strings.Repeat("ab", 32) + `"
+    - name: qux
+      path: bin/qux
+`
+	if err := os.WriteFile(filepath.Join(root, ".terraform-docs.yml"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := print.ReadConfig(root, ".terraform-docs.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plugins, err := All(config)
+
+	assert.Nil(err)
+	assert.Equal([]*Plugin{
+		{Name: "bar", Path: filepath.Join(dirs[SourceLocal], "tfdocs-format-bar"), Source: SourceLocal},
+		{Name: "foo", Path: "bin/tfdocs-format-foo", Checksum: strings.Repeat("ab", 32), Source: SourceConfig},
+		{Name: "qux", Path: "bin/qux", Source: SourceConfig},
+	}, withoutSuffix(plugins))
+}
+
+func TestFind(t *testing.T) {
+	tests := map[string]struct {
+		name     string
+		expected string
+		wantErr  string
+	}{
+		"Found": {
+			name:     "foo",
+			expected: SourceHome,
+		},
+		"NotFound": {
+			name:    "bar",
+			wantErr: "plugin 'bar' not found",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			withPluginsDirs(t, map[string][]string{
+				SourceHome: {"tfdocs-format-foo"},
+			})
+
+			list := NewList()
+			defer list.Clean()
+
+			p, err := list.Find(nil, tt.name)
+
+			if tt.wantErr != "" {
+				assert.EqualError(err, tt.wantErr)
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, p.Source)
+			}
+		})
+	}
+}
+
+func withoutSuffix(plugins []*Plugin) []*Plugin {
+	for _, p := range plugins {
+		p.Path = strings.TrimSuffix(p.Path, ".exe")
+	}
+	return plugins
+}
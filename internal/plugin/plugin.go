@@ -11,9 +11,17 @@ the root directory of this source tree.
 package plugin
 
 import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os/exec"
+	"sync"
+
 	goplugin "github.com/hashicorp/go-plugin"
 
 	pluginsdk "github.com/terraform-docs/terraform-docs/plugin"
+	"github.com/terraform-docs/terraform-docs/print"
 )
 
 // namePrefix is the mandatory prefix for name of the plugin file. What
@@ -25,32 +33,207 @@ const namePrefix = "tfdocs-format-"
 var homePluginsRoot = "~/.tfdocs.d/plugins"
 var localPluginsRoot = "./.tfdocs.d/plugins"
 
+// errNotFound is returned when a plugin is not found.
+var errNotFound = errors.New("not found")
+
+// Info represents the information of a launched plugin.
+type Info struct {
+	*Plugin
+
+	Version      string
+	Protocol     int
+	Capabilities []string
+}
+
 // List is an object caching discovered plugins and their corresponding
 // clients. Basically, it is a wrapper for go-plugin and provides an API
 // to handle them collectively.
+//
+// Plugins directories are only read on first lookup, and plugins are only
+// launched on first use. Launched plugins are cached by their path, and are
+// safe to be used concurrently.
 type List struct {
-	formatters map[string]*pluginsdk.Client
+	mu sync.Mutex
+
+	discovered map[string]*Plugin
+	plugins    map[string]pluginsdk.Formatter
 	clients    map[string]*goplugin.Client
 }
 
-// All returns all registered plugins.
-func (l *List) All() []*pluginsdk.Client {
-	all := make([]*pluginsdk.Client, 0)
-	for _, f := range l.formatters {
-		all = append(all, f)
+// NewList returns new instance of List.
+func NewList() *List {
+	return &List{
+		plugins: map[string]pluginsdk.Formatter{},
+		clients: map[string]*goplugin.Client{},
 	}
-	return all
 }
 
-// Get plugin by its name.
-func (l *List) Get(name string) (*pluginsdk.Client, bool) {
-	client, ok := l.formatters[name]
-	return client, ok
+// Find returns the plugin with 'name'. Plugins declared in 'plugins.sources'
+// of 'config' take precedence over the ones found in plugins directories.
+func (l *List) Find(config *print.Config, name string) (*Plugin, error) {
+	for _, p := range Declared(config) {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.discovered == nil {
+		plugins, err := Discover()
+		if err != nil {
+			return nil, err
+		}
+		l.discovered = map[string]*Plugin{}
+		for _, p := range plugins {
+			l.discovered[p.Name] = p
+		}
+	}
+
+	p, ok := l.discovered[name]
+	if !ok {
+		return nil, fmt.Errorf("plugin '%s' %w", name, errNotFound)
+	}
+
+	return p, nil
+}
+
+// Launch launches the plugin 'p' if it's not already launched, and returns it.
+func (l *List) Launch(p *Plugin) (pluginsdk.Formatter, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if formatter, ok := l.plugins[p.Path]; ok {
+		return formatter, nil
+	}
+
+	checksum, err := hex.DecodeString(p.Checksum)
+	if err != nil {
+		return nil, fmt.Errorf("checksum of plugin %s is invalid: %w", p.Name, err)
+	}
+
+	// Accepting variables here is intentional; we need to determine the
+	// path on the fly per directory.
+	//
+	// nolint:gosec
+	cmd := exec.CommandContext(context.TODO(), p.Path)
+
+	client := pluginsdk.NewClient(&pluginsdk.ClientOpts{
+		Cmd:      cmd,
+		Checksum: checksum,
+	})
+
+	rpcClient, err := client.Client()
+	if err != nil {
+		client.Kill()
+		if errors.Is(err, goplugin.ErrChecksumsDoNotMatch) {
+			return nil, fmt.Errorf("checksum of plugin %s doesn't match %s", p.Name, p.Path)
+		}
+		return nil, fmt.Errorf("plugin %s is incompatible with this version of terraform-docs: %w", p.Name, err)
+	}
+
+	raw, err := rpcClient.Dispense("formatter")
+	if err != nil {
+		client.Kill()
+		return nil, err
+	}
+
+	formatter, ok := raw.(pluginsdk.Formatter)
+	if !ok {
+		client.Kill()
+		return nil, fmt.Errorf("plugin %s is not a terraform-docs plugin", p.Name)
+	}
+
+	l.clients[p.Path] = client
+	l.plugins[p.Path] = formatter
+
+	return formatter, nil
+}
+
+// Info launches the plugin 'p' and returns its information.
+func (l *List) Info(p *Plugin) (*Info, error) {
+	formatter, err := l.Launch(p)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := formatter.Version()
+	if err != nil {
+		return nil, err
+	}
+
+	capabilities, err := formatter.Capabilities()
+	if err != nil {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	protocol := l.clients[p.Path].NegotiatedVersion()
+	l.mu.Unlock()
+
+	return &Info{
+		Plugin:       p,
+		Version:      version,
+		Protocol:     protocol,
+		Capabilities: capabilities,
+	}, nil
+}
+
+// find looks up and launches the plugin with 'name' to be used as 'kind'.
+func (l *List) find(config *print.Config, kind string, name string) (pluginsdk.Formatter, error) {
+	p, err := l.Find(config, name)
+	if err != nil {
+		if errors.Is(err, errNotFound) {
+			return nil, fmt.Errorf("%s '%s' not found", kind, name)
+		}
+		return nil, err
+	}
+	return l.Launch(p)
+}
+
+// Formatter returns the formatter plugin by its name.
+func (l *List) Formatter(config *print.Config, name string) (pluginsdk.Formatter, error) {
+	return l.find(config, "formatter", name)
+}
+
+// Enricher returns the enricher plugin by its name, or an error if it's not
+// found or it's not served with a protocol version supporting enrichers.
+func (l *List) Enricher(config *print.Config, name string) (pluginsdk.Enricher, error) {
+	client, err := l.find(config, "enricher", name)
+	if err != nil {
+		return nil, err
+	}
+	enricher, ok := client.(pluginsdk.Enricher)
+	if !ok {
+		return nil, fmt.Errorf("plugin '%s' doesn't support enrichers, protocol version %d is required", name, pluginsdk.ProtocolVersionGRPC)
+	}
+	return enricher, nil
+}
+
+// PostProcessor returns the post-processor plugin by its name, or an error if
+// it's not found or it's not served with a protocol version supporting
+// post-processors.
+func (l *List) PostProcessor(config *print.Config, name string) (pluginsdk.PostProcessor, error) {
+	client, err := l.find(config, "post-processor", name)
+	if err != nil {
+		return nil, err
+	}
+	postProcessor, ok := client.(pluginsdk.PostProcessor)
+	if !ok {
+		return nil, fmt.Errorf("plugin '%s' doesn't support post-processors, protocol version %d is required", name, pluginsdk.ProtocolVersionGRPC)
+	}
+	return postProcessor, nil
 }
 
 // Clean is a helper for ending plugin processes.
 func (l *List) Clean() {
-	for _, client := range l.clients {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for path, client := range l.clients {
 		client.Kill()
+		delete(l.clients, path)
+		delete(l.plugins, path)
 	}
 }
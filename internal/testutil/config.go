@@ -26,9 +26,11 @@ func baseConfig() print.Config {
 func baseSections() print.Config {
 	base := baseConfig()
 
+	base.Sections.Checks = true
 	base.Sections.DataSources = true
 	base.Sections.Header = true
 	base.Sections.Inputs = true
+	base.Sections.Migrations = true
 	base.Sections.ModuleCalls = true
 	base.Sections.Outputs = true
 	base.Sections.Providers = true
@@ -0,0 +1,9 @@
+output "id" {
+  description = "The ID of the resource."
+  value       = null_resource.main.id
+
+  precondition {
+    condition     = null_resource.main.id != null
+    error_message = "The resource must be created first."
+  }
+}
@@ -0,0 +1,15 @@
+resource "aws_s3_bucket" "logs" {
+  count = 2
+}
+
+data "aws_caller_identity" "current" {}
+
+module "network" {
+  source   = "./modules/network"
+  for_each = toset(["blue", "green"])
+}
+
+module "vpc" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "5.0.0"
+}
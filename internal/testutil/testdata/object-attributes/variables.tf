@@ -0,0 +1,28 @@
+variable "name" {
+  description = "Name of the service."
+  type        = string
+}
+
+variable "settings" {
+  description = "Settings of the service."
+  type = object({
+    # Whether the service is enabled.
+    enabled = bool
+
+    # Number of days to keep the logs for.
+    # Older logs are deleted.
+    retention_days = optional(number, 7)
+
+    tags = optional(map(string)) # Additional tags of the service.
+
+    # Firewall rules of the service.
+    rules = optional(list(object({
+      name     = string                       # Name of the rule.
+      priority = optional(number, 100)        # Priority of the rule.
+      ports    = optional(list(number), [80]) # Ports the rule applies to.
+    })), [])
+  })
+  default = {
+    enabled = true
+  }
+}
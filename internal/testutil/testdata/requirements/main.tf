@@ -0,0 +1,28 @@
+terraform {
+  required_version = ">= 1.3"
+
+  required_providers {
+    aws = {
+      source                = "hashicorp/aws"
+      version               = ">= 4.0"
+      configuration_aliases = [aws.east, aws.west]
+    }
+    random = {
+      source = "hashicorp/random"
+    }
+  }
+}
+
+resource "aws_s3_bucket" "east" {
+  provider = aws.east
+}
+
+resource "aws_s3_bucket" "west" {
+  provider = aws.west
+}
+
+resource "random_id" "this" {
+  byte_length = 8
+}
+
+resource "null_resource" "this" {}
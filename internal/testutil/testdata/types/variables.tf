@@ -0,0 +1,33 @@
+variable "name" {
+  type = string
+}
+
+variable "legacy" {
+  type = "list"
+}
+
+variable "ports" {
+  type = set(number)
+}
+
+variable "pair" {
+  type = tuple([string, bool])
+}
+
+variable "untyped" {
+  default = "foo"
+}
+
+variable "settings" {
+  type = object({
+    # Whether the feature is enabled.
+    enabled = bool
+    "retention-days" = optional(number, 7)
+    tags    = optional(map(string)) // Additional tags.
+    rules = list(object({
+      name     = string
+      priority = optional(number, 100)
+      ports    = optional(list(number), [80, 443])
+    }))
+  })
+}
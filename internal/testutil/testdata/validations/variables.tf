@@ -0,0 +1,45 @@
+variable "name" {
+  description = "Name of the resource."
+  type        = string
+
+  validation {
+    condition     = length(var.name) > 3
+    error_message = "The name must be longer than 3 characters."
+  }
+
+  validation {
+    condition     = can(regex("^[a-z_]+$", var.name))
+    error_message = "The name must only contain lowercase letters and underscores."
+  }
+}
+
+variable "size" {
+  description = "Size of the instance."
+  type        = string
+  default     = "small"
+
+  validation {
+    condition = contains(
+      ["small", "medium", "large"],
+      var.size
+    )
+    error_message = "Allowed values are ${join(", ", ["small", "medium", "large"])}."
+  }
+}
+
+variable "retries" {
+  description = "Number of retries."
+  type        = number
+  default     = 3
+
+  validation {
+    condition     = var.retries == 0 || var.retries > 2
+    error_message = "Retries must be either 0 or more than 2."
+  }
+}
+
+variable "tags" {
+  description = "Tags to attach."
+  type        = map(string)
+  default     = {}
+}
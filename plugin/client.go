@@ -11,6 +11,7 @@ the root directory of this source tree.
 package plugin
 
 import (
+	"crypto/sha256"
 	"net/rpc"
 	"os"
 	"os/exec"
@@ -31,6 +32,10 @@ type Client struct {
 // ClientOpts is an option for initializing a Client.
 type ClientOpts struct {
 	Cmd *exec.Cmd
+
+	// Checksum is the SHA256 checksum of the plugin executable. If it's set,
+	// the executable is verified against it before being launched.
+	Checksum []byte
 }
 
 // ExecuteArgs is the collection of arguments being sent by terraform-docs
@@ -40,20 +45,33 @@ type ExecuteArgs struct {
 	Config *print.Config
 }
 
+// PostProcessArgs is the collection of arguments being sent by terraform-docs
+// core while post-processing the generated content.
+type PostProcessArgs struct {
+	Content string
+	Config  *print.Config
+}
+
 // NewClient is a wrapper of plugin.NewClient.
 func NewClient(opts *ClientOpts) *goplugin.Client {
-	return goplugin.NewClient(&goplugin.ClientConfig{
-		HandshakeConfig: handshakeConfig,
-		Plugins: map[string]goplugin.Plugin{
-			"formatter": &formatter{},
-		},
-		Cmd: opts.Cmd,
+	config := &goplugin.ClientConfig{
+		HandshakeConfig:  handshakeConfig,
+		VersionedPlugins: pluginSets(&formatter{}),
+		AllowedProtocols: []goplugin.Protocol{goplugin.ProtocolNetRPC, goplugin.ProtocolGRPC},
+		Cmd:              opts.Cmd,
 		Logger: hclog.New(&hclog.LoggerOptions{
 			Name:   "plugin",
 			Output: os.Stderr,
 			Level:  hclog.LevelFromString(os.Getenv("TFDOCS_LOG")),
 		}),
-	})
+	}
+	if len(opts.Checksum) > 0 {
+		config.SecureConfig = &goplugin.SecureConfig{
+			Checksum: opts.Checksum,
+			Hash:     sha256.New(),
+		}
+	}
+	return goplugin.NewClient(config)
 }
 
 // Name calls the server-side Name method and returns its version.
@@ -70,6 +88,12 @@ func (c *Client) Version() (string, error) {
 	return resp, err
 }
 
+// Capabilities returns the capabilities of the plugin. Plugins served with the
+// net/rpc protocol don't report their capabilities and can only be formatters.
+func (c *Client) Capabilities() ([]string, error) {
+	return []string{CapabilityFormatter}, nil
+}
+
 // Execute calls the server-side Execute method and returns generated output.
 func (c *Client) Execute(args *ExecuteArgs) (string, error) {
 	var resp string
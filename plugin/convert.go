@@ -0,0 +1,579 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package plugin
+
+import (
+	"encoding/json"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+	"github.com/terraform-docs/terraform-docs/plugin/proto"
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+// encodeValue returns the JSON representation of 'v', or nil if it's not set.
+func encodeValue(v types.Value) []byte {
+	if v == nil {
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// decodeValue returns the value of its JSON representation 'data', or nil if
+// it's empty.
+func decodeValue(data []byte) types.Value {
+	if len(data) == 0 {
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil
+	}
+	return types.ValueOf(v)
+}
+
+func toProtoPosition(p terraform.Position) *proto.Position {
+	return &proto.Position{
+		Filename: p.Filename,
+		Line:     int64(p.Line),
+	}
+}
+
+func fromProtoPosition(p *proto.Position) terraform.Position {
+	return terraform.Position{
+		Filename: p.GetFilename(),
+		Line:     int(p.GetLine()),
+	}
+}
+
+func toProtoTypeSchema(t *terraform.TypeSchema) *proto.TypeSchema {
+	if t == nil {
+		return nil
+	}
+	schema := &proto.TypeSchema{
+		Kind:    t.Kind,
+		Element: toProtoTypeSchema(t.Element),
+	}
+	for _, e := range t.Elements {
+		schema.Elements = append(schema.Elements, toProtoTypeSchema(e))
+	}
+	for _, a := range t.Attributes {
+		schema.Attributes = append(schema.Attributes, &proto.TypeAttribute{
+			Name:        a.Name,
+			Description: string(a.Description),
+			Type:        toProtoTypeSchema(a.Type),
+			Optional:    a.Optional,
+			Default:     encodeValue(a.Default),
+		})
+	}
+	return schema
+}
+
+func fromProtoTypeSchema(t *proto.TypeSchema) *terraform.TypeSchema {
+	if t == nil {
+		return nil
+	}
+	schema := &terraform.TypeSchema{
+		Kind:    t.GetKind(),
+		Element: fromProtoTypeSchema(t.GetElement()),
+	}
+	switch schema.Kind {
+	case terraform.TypeTuple:
+		schema.Elements = make([]*terraform.TypeSchema, 0, len(t.GetElements()))
+	case terraform.TypeObject:
+		schema.Attributes = make([]*terraform.TypeAttribute, 0, len(t.GetAttributes()))
+	}
+	for _, e := range t.GetElements() {
+		schema.Elements = append(schema.Elements, fromProtoTypeSchema(e))
+	}
+	for _, a := range t.GetAttributes() {
+		schema.Attributes = append(schema.Attributes, &terraform.TypeAttribute{
+			Name:        a.GetName(),
+			Description: types.String(a.GetDescription()),
+			Type:        fromProtoTypeSchema(a.GetType()),
+			Optional:    a.GetOptional(),
+			Default:     decodeValue(a.GetDefault()),
+		})
+	}
+	return schema
+}
+
+func toProtoInput(i *terraform.Input) *proto.Input {
+	input := &proto.Input{
+		Name:        i.Name,
+		Type:        string(i.Type),
+		TypeSchema:  toProtoTypeSchema(i.TypeSchema),
+		Description: string(i.Description),
+		Default:     encodeValue(i.Default),
+		Example:     encodeValue(i.Example),
+		Required:    i.Required,
+		Sensitive:   i.Sensitive,
+		Nullable:    i.Nullable,
+		Ephemeral:   i.Ephemeral,
+		Position:    toProtoPosition(i.Position),
+	}
+	for _, v := range i.Validations {
+		input.Validations = append(input.Validations, &proto.Validation{
+			Condition:    v.Condition,
+			ErrorMessage: string(v.ErrorMessage),
+		})
+	}
+	return input
+}
+
+func fromProtoInput(i *proto.Input) *terraform.Input {
+	input := &terraform.Input{
+		Name:        i.GetName(),
+		Type:        types.String(i.GetType()),
+		TypeSchema:  fromProtoTypeSchema(i.GetTypeSchema()),
+		Description: types.String(i.GetDescription()),
+		Default:     decodeValue(i.GetDefault()),
+		Example:     decodeValue(i.GetExample()),
+		Required:    i.GetRequired(),
+		Sensitive:   i.GetSensitive(),
+		Nullable:    i.GetNullable(),
+		Ephemeral:   i.GetEphemeral(),
+		Validations: make([]*terraform.Validation, 0, len(i.GetValidations())),
+		Position:    fromProtoPosition(i.GetPosition()),
+	}
+	if input.Default == nil {
+		input.Default = types.ValueOf(nil)
+	}
+	if input.Example == nil {
+		input.Example = types.ValueOf(nil)
+	}
+	for _, v := range i.GetValidations() {
+		input.Validations = append(input.Validations, &terraform.Validation{
+			Condition:    v.GetCondition(),
+			ErrorMessage: types.String(v.GetErrorMessage()),
+		})
+	}
+	return input
+}
+
+// toProtoModuleCall converts 'mc' to its protobuf representation, along with
+// its nested resources and module calls.
+func toProtoModuleCall(mc *terraform.ModuleCall) *proto.ModuleCall {
+	m := &proto.ModuleCall{
+		Name:         mc.Name,
+		Source:       mc.Source,
+		Version:      mc.Version,
+		Description:  string(mc.Description),
+		Position:     toProtoPosition(mc.Position),
+		MetaArgument: mc.MetaArgument,
+		Address:      mc.Address,
+	}
+	for _, r := range mc.Resources {
+		m.Resources = append(m.Resources, toProtoResource(r))
+	}
+	for _, c := range mc.ModuleCalls {
+		m.ModuleCalls = append(m.ModuleCalls, toProtoModuleCall(c))
+	}
+	return m
+}
+
+// fromProtoModuleCall converts the protobuf representation of a module call
+// back to 'terraform.ModuleCall'.
+func fromProtoModuleCall(m *proto.ModuleCall) *terraform.ModuleCall {
+	mc := &terraform.ModuleCall{
+		Name:         m.GetName(),
+		Source:       m.GetSource(),
+		Version:      m.GetVersion(),
+		Description:  types.String(m.GetDescription()),
+		MetaArgument: m.GetMetaArgument(),
+		Address:      m.GetAddress(),
+		Position:     fromProtoPosition(m.GetPosition()),
+	}
+	if mc.Address != "" {
+		// nested items of local modules are never nil
+		mc.Resources = make([]*terraform.Resource, 0, len(m.GetResources()))
+		mc.ModuleCalls = make([]*terraform.ModuleCall, 0, len(m.GetModuleCalls()))
+	}
+	for _, r := range m.GetResources() {
+		mc.Resources = append(mc.Resources, fromProtoResource(r))
+	}
+	for _, c := range m.GetModuleCalls() {
+		mc.ModuleCalls = append(mc.ModuleCalls, fromProtoModuleCall(c))
+	}
+	return mc
+}
+
+// toProtoResource converts 'r' to its protobuf representation.
+func toProtoResource(r *terraform.Resource) *proto.Resource {
+	return &proto.Resource{
+		Type:           r.Type,
+		Name:           r.Name,
+		ProviderName:   r.ProviderName,
+		ProviderSource: r.ProviderSource,
+		Mode:           r.Mode,
+		Version:        string(r.Version),
+		Description:    string(r.Description),
+		Position:       toProtoPosition(r.Position),
+		MetaArgument:   r.MetaArgument,
+		Address:        r.Address,
+	}
+}
+
+// fromProtoResource converts the protobuf representation of a resource back
+// to 'terraform.Resource'.
+func fromProtoResource(r *proto.Resource) *terraform.Resource {
+	return &terraform.Resource{
+		Type:           r.GetType(),
+		Name:           r.GetName(),
+		ProviderName:   r.GetProviderName(),
+		ProviderSource: r.GetProviderSource(),
+		Mode:           r.GetMode(),
+		Version:        types.String(r.GetVersion()),
+		Description:    types.String(r.GetDescription()),
+		MetaArgument:   r.GetMetaArgument(),
+		Address:        r.GetAddress(),
+		Position:       fromProtoPosition(r.GetPosition()),
+	}
+}
+
+// toProtoModule converts 'module' to its protobuf representation.
+func toProtoModule(module *terraform.Module) *proto.Module {
+	if module == nil {
+		return nil
+	}
+	m := &proto.Module{
+		Header: module.Header,
+		Footer: module.Footer,
+	}
+	for _, i := range module.Inputs {
+		m.Inputs = append(m.Inputs, toProtoInput(i))
+	}
+	for _, mc := range module.ModuleCalls {
+		m.ModuleCalls = append(m.ModuleCalls, toProtoModuleCall(mc))
+	}
+	for _, o := range module.Outputs {
+		m.Outputs = append(m.Outputs, &proto.Output{
+			Name:        o.Name,
+			Description: string(o.Description),
+			Value:       encodeValue(o.Value),
+			Sensitive:   o.Sensitive,
+			ShowValue:   o.ShowValue,
+			Position:    toProtoPosition(o.Position),
+		})
+	}
+	for _, p := range module.Providers {
+		m.Providers = append(m.Providers, &proto.Provider{
+			Name:       p.Name,
+			Alias:      string(p.Alias),
+			Version:    string(p.Version),
+			Source:     p.Source,
+			Constraint: string(p.Constraint),
+			Locked:     string(p.Locked),
+			Hashes:     p.Hashes,
+			Position:   toProtoPosition(p.Position),
+		})
+	}
+	for _, r := range module.Requirements {
+		m.Requirements = append(m.Requirements, &proto.Requirement{
+			Name:                 r.Name,
+			Version:              string(r.Version),
+			Source:               string(r.Source),
+			ConfigurationAliases: r.ConfigurationAliases,
+		})
+	}
+	for _, r := range module.Resources {
+		m.Resources = append(m.Resources, toProtoResource(r))
+	}
+	for _, c := range module.Checks {
+		m.Checks = append(m.Checks, &proto.Check{
+			Type:         c.Type,
+			Owner:        c.Owner,
+			Condition:    c.Condition,
+			ErrorMessage: string(c.ErrorMessage),
+			File:         c.File,
+			Line:         int64(c.Line),
+		})
+	}
+	for _, mg := range module.Migrations {
+		m.Migrations = append(m.Migrations, &proto.Migration{
+			Type:        mg.Type,
+			From:        mg.From,
+			To:          mg.To,
+			Id:          mg.ID,
+			Description: string(mg.Description),
+			File:        mg.File,
+			Line:        int64(mg.Line),
+		})
+	}
+	return m
+}
+
+// fromProtoModule converts the protobuf representation of a module back to
+// 'terraform.Module'.
+func fromProtoModule(m *proto.Module) *terraform.Module {
+	module := &terraform.Module{
+		Header:       m.GetHeader(),
+		Footer:       m.GetFooter(),
+		Checks:       make([]*terraform.Check, 0, len(m.GetChecks())),
+		Inputs:       make([]*terraform.Input, 0, len(m.GetInputs())),
+		Migrations:   make([]*terraform.Migration, 0, len(m.GetMigrations())),
+		ModuleCalls:  make([]*terraform.ModuleCall, 0, len(m.GetModuleCalls())),
+		Outputs:      make([]*terraform.Output, 0, len(m.GetOutputs())),
+		Providers:    make([]*terraform.Provider, 0, len(m.GetProviders())),
+		Requirements: make([]*terraform.Requirement, 0, len(m.GetRequirements())),
+		Resources:    make([]*terraform.Resource, 0, len(m.GetResources())),
+
+		RequiredInputs: make([]*terraform.Input, 0),
+		OptionalInputs: make([]*terraform.Input, 0),
+	}
+	for _, i := range m.GetInputs() {
+		input := fromProtoInput(i)
+		module.Inputs = append(module.Inputs, input)
+		if input.HasDefault() {
+			module.OptionalInputs = append(module.OptionalInputs, input)
+		} else {
+			module.RequiredInputs = append(module.RequiredInputs, input)
+		}
+	}
+	for _, mc := range m.GetModuleCalls() {
+		module.ModuleCalls = append(module.ModuleCalls, fromProtoModuleCall(mc))
+	}
+	for _, o := range m.GetOutputs() {
+		module.Outputs = append(module.Outputs, &terraform.Output{
+			Name:        o.GetName(),
+			Description: types.String(o.GetDescription()),
+			Value:       decodeValue(o.GetValue()),
+			Sensitive:   o.GetSensitive(),
+			ShowValue:   o.GetShowValue(),
+			Position:    fromProtoPosition(o.GetPosition()),
+		})
+	}
+	for _, p := range m.GetProviders() {
+		module.Providers = append(module.Providers, &terraform.Provider{
+			Name:       p.GetName(),
+			Alias:      types.String(p.GetAlias()),
+			Version:    types.String(p.GetVersion()),
+			Source:     p.GetSource(),
+			Constraint: types.String(p.GetConstraint()),
+			Locked:     types.String(p.GetLocked()),
+			Hashes:     append([]string{}, p.GetHashes()...),
+			Position:   fromProtoPosition(p.GetPosition()),
+		})
+	}
+	for _, r := range m.GetRequirements() {
+		module.Requirements = append(module.Requirements, &terraform.Requirement{
+			Name:                 r.GetName(),
+			Version:              types.String(r.GetVersion()),
+			Source:               types.String(r.GetSource()),
+			ConfigurationAliases: append([]string{}, r.GetConfigurationAliases()...),
+		})
+	}
+	for _, r := range m.GetResources() {
+		module.Resources = append(module.Resources, fromProtoResource(r))
+	}
+	for _, c := range m.GetChecks() {
+		module.Checks = append(module.Checks, &terraform.Check{
+			Type:         c.GetType(),
+			Owner:        c.GetOwner(),
+			Condition:    c.GetCondition(),
+			ErrorMessage: types.String(c.GetErrorMessage()),
+			File:         c.GetFile(),
+			Line:         int(c.GetLine()),
+		})
+	}
+	for _, mg := range m.GetMigrations() {
+		module.Migrations = append(module.Migrations, &terraform.Migration{
+			Type:        mg.GetType(),
+			From:        mg.GetFrom(),
+			To:          mg.GetTo(),
+			ID:          mg.GetId(),
+			Description: types.String(mg.GetDescription()),
+			File:        mg.GetFile(),
+			Line:        int(mg.GetLine()),
+		})
+	}
+	return module
+}
+
+// toProtoConfig converts 'config' to its protobuf representation.
+func toProtoConfig(config *print.Config) *proto.Config {
+	if config == nil {
+		return nil
+	}
+	return &proto.Config{
+		Formatter:  config.Formatter,
+		Version:    config.Version,
+		HeaderFrom: config.HeaderFrom,
+		FooterFrom: config.FooterFrom,
+		Content:    config.Content,
+		Sections: &proto.Config_Sections{
+			Show:         config.Sections.Show,
+			Hide:         config.Sections.Hide,
+			Checks:       config.Sections.Checks,
+			DataSources:  config.Sections.DataSources,
+			Header:       config.Sections.Header,
+			Footer:       config.Sections.Footer,
+			Inputs:       config.Sections.Inputs,
+			Migrations:   config.Sections.Migrations,
+			ModuleCalls:  config.Sections.ModuleCalls,
+			Outputs:      config.Sections.Outputs,
+			Providers:    config.Sections.Providers,
+			Requirements: config.Sections.Requirements,
+			Resources:    config.Sections.Resources,
+		},
+		Output: &proto.Config_Output{
+			File:     config.Output.File,
+			Mode:     config.Output.Mode,
+			Template: config.Output.Template,
+			Check:    config.Output.Check,
+		},
+		OutputValues: &proto.Config_OutputValues{
+			Enabled: config.OutputValues.Enabled,
+			From:    config.OutputValues.From,
+		},
+		Sort: &proto.Config_Sort{
+			Enabled: config.Sort.Enabled,
+			By:      config.Sort.By,
+		},
+		Settings: &proto.Config_Settings{
+			Anchor:          config.Settings.Anchor,
+			Attributes:      config.Settings.Attributes,
+			AtxClosed:       config.Settings.AtxClosed,
+			Color:           config.Settings.Color,
+			Default:         config.Settings.Default,
+			Description:     config.Settings.Description,
+			Ephemeral:       config.Settings.Ephemeral,
+			Escape:          config.Settings.Escape,
+			HideEmpty:       config.Settings.HideEmpty,
+			Html:            config.Settings.HTML,
+			Indent:          int64(config.Settings.Indent),
+			Lockfile:        config.Settings.LockFile,
+			Nullable:        config.Settings.Nullable,
+			ReadComments:    config.Settings.ReadComments,
+			Required:        config.Settings.Required,
+			Sensitive:       config.Settings.Sensitive,
+			Type:            config.Settings.Type,
+			Validation:      config.Settings.Validation,
+			NestedResources: config.Settings.NestedResources,
+			Source:          config.Settings.Source,
+		},
+		ExampleValues: &proto.Config_ExampleValues{
+			Enabled: config.ExampleValues.Enabled,
+			From:    config.ExampleValues.From,
+		},
+		Usage: &proto.Config_Usage{
+			Name:    config.Usage.Name,
+			Source:  config.Usage.Source,
+			Version: config.Usage.Version,
+		},
+		Registry:   toProtoRegistry(config),
+		ModuleRoot: config.ModuleRoot,
+	}
+}
+
+func toProtoRegistry(config *print.Config) *proto.Config_Registry {
+	registry := &proto.Config_Registry{
+		Host: config.Registry.Host,
+	}
+	for _, h := range config.Registry.Hosts {
+		registry.Hosts = append(registry.Hosts, &proto.Config_Registry_Host{
+			Host:     h.Host,
+			Provider: h.Provider,
+			Resource: h.Resource,
+		})
+	}
+	for _, p := range config.Registry.Providers {
+		registry.Providers = append(registry.Providers, &proto.Config_Registry_Provider{
+			Name:     p.Name,
+			Provider: p.Provider,
+			Resource: p.Resource,
+		})
+	}
+	return registry
+}
+
+// fromProtoConfig converts the protobuf representation of a config back to
+// 'print.Config'.
+func fromProtoConfig(c *proto.Config) *print.Config {
+	config := print.NewConfig()
+
+	config.Formatter = c.GetFormatter()
+	config.Version = c.GetVersion()
+	config.HeaderFrom = c.GetHeaderFrom()
+	config.FooterFrom = c.GetFooterFrom()
+	config.Content = c.GetContent()
+	config.ModuleRoot = c.GetModuleRoot()
+
+	sections := c.GetSections()
+	config.Sections.Show = sections.GetShow()
+	config.Sections.Hide = sections.GetHide()
+	config.Sections.Checks = sections.GetChecks()
+	config.Sections.DataSources = sections.GetDataSources()
+	config.Sections.Header = sections.GetHeader()
+	config.Sections.Footer = sections.GetFooter()
+	config.Sections.Inputs = sections.GetInputs()
+	config.Sections.Migrations = sections.GetMigrations()
+	config.Sections.ModuleCalls = sections.GetModuleCalls()
+	config.Sections.Outputs = sections.GetOutputs()
+	config.Sections.Providers = sections.GetProviders()
+	config.Sections.Requirements = sections.GetRequirements()
+	config.Sections.Resources = sections.GetResources()
+
+	output := c.GetOutput()
+	config.Output.File = output.GetFile()
+	config.Output.Mode = output.GetMode()
+	config.Output.Template = output.GetTemplate()
+	config.Output.Check = output.GetCheck()
+
+	config.OutputValues.Enabled = c.GetOutputValues().GetEnabled()
+	config.OutputValues.From = c.GetOutputValues().GetFrom()
+
+	config.ExampleValues.Enabled = c.GetExampleValues().GetEnabled()
+	config.ExampleValues.From = c.GetExampleValues().GetFrom()
+
+	config.Usage.Name = c.GetUsage().GetName()
+	config.Usage.Source = c.GetUsage().GetSource()
+	config.Usage.Version = c.GetUsage().GetVersion()
+
+	config.Registry.Host = c.GetRegistry().GetHost()
+	for _, h := range c.GetRegistry().GetHosts() {
+		config.Registry.AddHost(h.GetHost(), h.GetProvider(), h.GetResource())
+	}
+	for _, p := range c.GetRegistry().GetProviders() {
+		config.Registry.AddProvider(p.GetName(), p.GetProvider(), p.GetResource())
+	}
+
+	config.Sort.Enabled = c.GetSort().GetEnabled()
+	config.Sort.By = c.GetSort().GetBy()
+
+	settings := c.GetSettings()
+	config.Settings.Anchor = settings.GetAnchor()
+	config.Settings.Attributes = settings.GetAttributes()
+	config.Settings.AtxClosed = settings.GetAtxClosed()
+	config.Settings.Color = settings.GetColor()
+	config.Settings.Default = settings.GetDefault()
+	config.Settings.Description = settings.GetDescription()
+	config.Settings.Ephemeral = settings.GetEphemeral()
+	config.Settings.Escape = settings.GetEscape()
+	config.Settings.HideEmpty = settings.GetHideEmpty()
+	config.Settings.HTML = settings.GetHtml()
+	config.Settings.Indent = int(settings.GetIndent())
+	config.Settings.LockFile = settings.GetLockfile()
+	config.Settings.NestedResources = settings.GetNestedResources()
+	config.Settings.Nullable = settings.GetNullable()
+	config.Settings.ReadComments = settings.GetReadComments()
+	config.Settings.Required = settings.GetRequired()
+	config.Settings.Sensitive = settings.GetSensitive()
+	config.Settings.Source = settings.GetSource()
+	config.Settings.Type = settings.GetType()
+	config.Settings.Validation = settings.GetValidation()
+
+	return config
+}
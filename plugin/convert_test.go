@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package plugin
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+func TestConvertModule(t *testing.T) {
+	tests := map[string]struct {
+		path            string
+		exampleValues   bool
+		nestedResources bool
+	}{
+		"Examples": {
+			path: filepath.Join("..", "examples"),
+		},
+		"Types": {
+			path: filepath.Join("..", "internal", "testutil", "testdata", "types"),
+		},
+		"Checks": {
+			path: filepath.Join("..", "internal", "testutil", "testdata", "checks"),
+		},
+		"Migrations": {
+			path: filepath.Join("..", "internal", "testutil", "testdata", "migrations"),
+		},
+		"ExampleValues": {
+			path:          filepath.Join("..", "internal", "testutil", "testdata", "example-values"),
+			exampleValues: true,
+		},
+		"NestedResources": {
+			path:            filepath.Join("..", "internal", "testutil", "testdata", "nested-resources"),
+			nestedResources: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.DefaultConfig()
+			config.ModuleRoot = tt.path
+			config.ExampleValues.Enabled = tt.exampleValues
+			config.Settings.NestedResources = tt.nestedResources
+
+			module, err := terraform.LoadWithOptions(config)
+			assert.Nil(err)
+
+			assert.Equal(module, fromProtoModule(toProtoModule(module)))
+		})
+	}
+}
+
+func TestConvertConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.DefaultConfig()
+	config.Formatter = "template"
+	config.Content = "{{ .Inputs }}"
+	config.ModuleRoot = "examples"
+	config.Sections.Show = []string{"inputs"}
+	config.Sections.Hide = []string{}
+	config.Settings.Indent = 3
+	config.Settings.HTML = false
+	config.Settings.NestedResources = true
+	config.Settings.Source = true
+	config.Output.Check = true
+	config.ExampleValues.Enabled = true
+	config.ExampleValues.From = []string{"examples.tfvars"}
+	config.Usage.Name = "foo"
+	config.Usage.Source = "acme/foo/aws"
+	config.Usage.Version = "~> 1.0"
+	config.Registry.Host = "registry.opentofu.org"
+	config.Registry.AddHost("registry.acme.com", "https://docs.acme.com/{{ .Name }}", "")
+	config.Registry.AddProvider("foo", "https://wiki.acme.com/foo", "https://wiki.acme.com/foo/{{ .Type }}")
+
+	actual := fromProtoConfig(toProtoConfig(config))
+
+	// recursive mode, output comment markers, html page and plugins pipelines
+	// are handled by the host only, and are not sent to plugins
+	config.Recursive = actual.Recursive
+	config.HTML = actual.HTML
+	config.Plugins = actual.Plugins
+	config.Output.BeginComment = actual.Output.BeginComment
+	config.Output.EndComment = actual.Output.EndComment
+
+	assert.Equal(config, actual)
+}
@@ -19,6 +19,35 @@ the root directory of this source tree.
 // Implementation details are hidden in go-plugin. This package is
 // essentially a wrapper for go-plugin.
 //
+// # Protocols
+//
+// Plugins are served with two protocol versions and the host negotiates
+// the latest one both sides support:
+//
+//   - ProtocolVersionNetRPC (7) is the legacy net/rpc protocol, where the
+//     module and config are gob encoded Go structs. It requires the host and
+//     plugin to be built with the exact same version of terraform-docs.
+//   - ProtocolVersionGRPC (8) is the gRPC protocol, where the module and
+//     config are encoded with the stable protobuf schema of plugin/proto.
+//
+// With the gRPC protocol, the host starts with a handshake in which the
+// plugin reports its name, version, supported protocol versions and
+// capabilities. The host refuses to use a plugin which lacks the capability
+// needed for the task.
+//
+// # Capabilities
+//
+// A plugin has the capabilities of the functions set in ServeOpts:
+//
+//   - CapabilityFormatter (Printer) generates the content of a module.
+//   - CapabilityEnricher (Enricher) mutates the loaded module before the
+//     content is generated.
+//   - CapabilityPostProcessor (PostProcessor) processes the generated content
+//     before it's written out.
+//
+// Enrichers and post-processors are only supported with ProtocolVersionGRPC,
+// and are configured as ordered pipelines in 'plugins' of the configuration.
+//
 // # Usage
 //
 // A simple plugin can look like this:
@@ -0,0 +1,294 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	goplugin "github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/terraform-docs/terraform-docs/internal/version"
+	"github.com/terraform-docs/terraform-docs/plugin/proto"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+// Ensure grpcFormatter fully satisfy plugin interface.
+var _ goplugin.GRPCPlugin = &grpcFormatter{}
+
+// Full names of the gRPC service and its methods, as declared in plugin.proto.
+const (
+	grpcServiceName       = "tfdocs.plugin.v2.Plugin"
+	grpcHandshakeMethod   = "/" + grpcServiceName + "/Handshake"
+	grpcExecuteMethod     = "/" + grpcServiceName + "/Execute"
+	grpcEnrichMethod      = "/" + grpcServiceName + "/Enrich"
+	grpcPostProcessMethod = "/" + grpcServiceName + "/PostProcess"
+)
+
+// grpcFormatter is a wrapper to satisfy the interface of go-plugin for the
+// gRPC protocol.
+type grpcFormatter struct {
+	goplugin.NetRPCUnsupportedPlugin
+
+	impl *formatter
+}
+
+// GRPCServer registers the gRPC server acting as a plugin.
+func (f *grpcFormatter) GRPCServer(_ *goplugin.GRPCBroker, s *grpc.Server) error {
+	s.RegisterService(&grpcServiceDesc, &GRPCServer{impl: f.impl})
+	return nil
+}
+
+// GRPCClient returns a gRPC client for the host.
+func (*grpcFormatter) GRPCClient(_ context.Context, _ *goplugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return &GRPCClient{conn: c}, nil
+}
+
+// grpcService is the interface of the gRPC service served by plugins.
+type grpcService interface {
+	Handshake(context.Context, *proto.Handshake_Request) (*proto.Handshake_Response, error)
+	Execute(context.Context, *proto.Execute_Request) (*proto.Execute_Response, error)
+	Enrich(context.Context, *proto.Enrich_Request) (*proto.Enrich_Response, error)
+	PostProcess(context.Context, *proto.PostProcess_Request) (*proto.PostProcess_Response, error)
+}
+
+var grpcServiceDesc = grpc.ServiceDesc{
+	ServiceName: grpcServiceName,
+	HandlerType: (*grpcService)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Handshake",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				req := new(proto.Handshake_Request)
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				return srv.(grpcService).Handshake(ctx, req)
+			},
+		},
+		{
+			MethodName: "Execute",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				req := new(proto.Execute_Request)
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				return srv.(grpcService).Execute(ctx, req)
+			},
+		},
+		{
+			MethodName: "Enrich",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				req := new(proto.Enrich_Request)
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				return srv.(grpcService).Enrich(ctx, req)
+			},
+		},
+		{
+			MethodName: "PostProcess",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				req := new(proto.PostProcess_Request)
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				return srv.(grpcService).PostProcess(ctx, req)
+			},
+		},
+	},
+	Metadata: "plugin/proto/plugin.proto",
+}
+
+// GRPCServer is a gRPC Server acting as a plugin.
+type GRPCServer struct {
+	impl *formatter
+}
+
+// Handshake returns the name, version, supported protocol versions and
+// capabilities of the plugin.
+func (s *GRPCServer) Handshake(_ context.Context, _ *proto.Handshake_Request) (*proto.Handshake_Response, error) {
+	return &proto.Handshake_Response{
+		Name:             s.impl.Name(),
+		Version:          s.impl.Version(),
+		ProtocolVersions: protocolVersions(),
+		Capabilities:     s.impl.Capabilities(),
+	}, nil
+}
+
+// Execute returns the generated output.
+func (s *GRPCServer) Execute(_ context.Context, req *proto.Execute_Request) (*proto.Execute_Response, error) {
+	content, err := s.impl.Execute(executeArgs(req.GetModule(), req.GetConfig()))
+	if err != nil {
+		return nil, err
+	}
+	return &proto.Execute_Response{Content: content}, nil
+}
+
+// Enrich returns the mutated module.
+func (s *GRPCServer) Enrich(_ context.Context, req *proto.Enrich_Request) (*proto.Enrich_Response, error) {
+	module, err := s.impl.Enrich(executeArgs(req.GetModule(), req.GetConfig()))
+	if err != nil {
+		return nil, err
+	}
+	return &proto.Enrich_Response{Module: toProtoModule(module)}, nil
+}
+
+// executeArgs converts the module and config sent by the host, with the
+// documentation URLs of the module resolved based on 'registry' config.
+func executeArgs(m *proto.Module, c *proto.Config) *ExecuteArgs {
+	module := fromProtoModule(m)
+	config := fromProtoConfig(c)
+	terraform.AttachRegistry(module, config)
+	return &ExecuteArgs{Module: module, Config: config}
+}
+
+// PostProcess returns the processed content.
+func (s *GRPCServer) PostProcess(_ context.Context, req *proto.PostProcess_Request) (*proto.PostProcess_Response, error) {
+	content, err := s.impl.PostProcess(&PostProcessArgs{
+		Content: req.GetContent(),
+		Config:  fromProtoConfig(req.GetConfig()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &proto.PostProcess_Response{Content: content}, nil
+}
+
+// GRPCClient is a gRPC Client for the host.
+type GRPCClient struct {
+	conn *grpc.ClientConn
+
+	once      sync.Once
+	handshake *proto.Handshake_Response
+	err       error
+}
+
+// Handshake calls the server-side Handshake method once and returns its
+// (cached) response.
+func (c *GRPCClient) Handshake() (*proto.Handshake_Response, error) {
+	c.once.Do(func() {
+		req := &proto.Handshake_Request{
+			HostVersion:      version.Short(),
+			ProtocolVersions: protocolVersions(),
+		}
+		resp := new(proto.Handshake_Response)
+		if err := c.conn.Invoke(context.Background(), grpcHandshakeMethod, req, resp); err != nil {
+			if status.Code(err) == codes.Unimplemented {
+				err = fmt.Errorf("plugin doesn't support handshake of protocol version %d: %w", ProtocolVersionGRPC, err)
+			}
+			c.err = err
+			return
+		}
+		c.handshake = resp
+	})
+	return c.handshake, c.err
+}
+
+// Name calls the server-side Handshake method and returns the name of the
+// plugin.
+func (c *GRPCClient) Name() (string, error) {
+	resp, err := c.Handshake()
+	return resp.GetName(), err
+}
+
+// Version calls the server-side Handshake method and returns the version of
+// the plugin.
+func (c *GRPCClient) Version() (string, error) {
+	resp, err := c.Handshake()
+	return resp.GetVersion(), err
+}
+
+// Capabilities calls the server-side Handshake method and returns the
+// capabilities of the plugin.
+func (c *GRPCClient) Capabilities() ([]string, error) {
+	resp, err := c.Handshake()
+	return resp.GetCapabilities(), err
+}
+
+// Execute calls the server-side Execute method and returns generated output.
+// It returns an error if the plugin doesn't have the 'formatter' capability.
+func (c *GRPCClient) Execute(args *ExecuteArgs) (string, error) {
+	if err := c.require(CapabilityFormatter); err != nil {
+		return "", err
+	}
+	req := &proto.Execute_Request{
+		Module: toProtoModule(args.Module),
+		Config: toProtoConfig(args.Config),
+	}
+	out := new(proto.Execute_Response)
+	if err := c.invoke(grpcExecuteMethod, req, out); err != nil {
+		return "", err
+	}
+	return out.GetContent(), nil
+}
+
+// Enrich calls the server-side Enrich method and returns the mutated module.
+// It returns an error if the plugin doesn't have the 'enricher' capability.
+func (c *GRPCClient) Enrich(args *ExecuteArgs) (*terraform.Module, error) {
+	if err := c.require(CapabilityEnricher); err != nil {
+		return nil, err
+	}
+	req := &proto.Enrich_Request{
+		Module: toProtoModule(args.Module),
+		Config: toProtoConfig(args.Config),
+	}
+	out := new(proto.Enrich_Response)
+	if err := c.invoke(grpcEnrichMethod, req, out); err != nil {
+		return nil, err
+	}
+	return fromProtoModule(out.GetModule()), nil
+}
+
+// PostProcess calls the server-side PostProcess method and returns the
+// processed content. It returns an error if the plugin doesn't have the
+// 'post-processor' capability.
+func (c *GRPCClient) PostProcess(args *PostProcessArgs) (string, error) {
+	if err := c.require(CapabilityPostProcessor); err != nil {
+		return "", err
+	}
+	req := &proto.PostProcess_Request{
+		Content: args.Content,
+		Config:  toProtoConfig(args.Config),
+	}
+	out := new(proto.PostProcess_Response)
+	if err := c.invoke(grpcPostProcessMethod, req, out); err != nil {
+		return "", err
+	}
+	return out.GetContent(), nil
+}
+
+// require returns an error if the plugin doesn't have 'capability'.
+func (c *GRPCClient) require(capability string) error {
+	resp, err := c.Handshake()
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(resp.GetCapabilities(), capability) {
+		return missingCapabilityError(resp.GetName(), capability)
+	}
+	return nil
+}
+
+// invoke calls the server-side 'method' and converts the returned status
+// error, if any, to a plain error with the message of the plugin.
+func (c *GRPCClient) invoke(method string, req interface{}, resp interface{}) error {
+	if err := c.conn.Invoke(context.Background(), method, req, resp); err != nil {
+		return errors.New(status.Convert(err).Message())
+	}
+	return nil
+}
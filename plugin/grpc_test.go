@@ -0,0 +1,252 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package plugin
+
+import (
+	"errors"
+	"testing"
+
+	goplugin "github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/print"
+	"github.com/terraform-docs/terraform-docs/terraform"
+)
+
+func dispenseGRPC(t *testing.T, f *formatter) *GRPCClient {
+	client, _ := goplugin.TestPluginGRPCConn(t, false, pluginSets(f)[ProtocolVersionGRPC])
+	t.Cleanup(func() { client.Close() })
+
+	raw, err := client.Dispense("formatter")
+	assert.Nil(t, err)
+
+	return raw.(*GRPCClient)
+}
+
+func TestGRPCHandshake(t *testing.T) {
+	tests := map[string]struct {
+		opts         ServeOpts
+		capabilities []string
+	}{
+		"Formatter": {
+			opts: ServeOpts{
+				Printer: func(*print.Config, *terraform.Module) (string, error) { return "", nil },
+			},
+			capabilities: []string{CapabilityFormatter},
+		},
+		"AllCapabilities": {
+			opts: ServeOpts{
+				Printer:       func(*print.Config, *terraform.Module) (string, error) { return "", nil },
+				Enricher:      func(*print.Config, *terraform.Module) (*terraform.Module, error) { return nil, nil },
+				PostProcessor: func(*print.Config, string) (string, error) { return "", nil },
+			},
+			capabilities: []string{CapabilityFormatter, CapabilityEnricher, CapabilityPostProcessor},
+		},
+		"NoCapabilities": {
+			opts:         ServeOpts{},
+			capabilities: []string{},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			opts := tt.opts
+			opts.Name = "foo"
+			opts.Version = "1.2.3"
+
+			client := dispenseGRPC(t, newFormatter(&opts))
+
+			name, err := client.Name()
+			assert.Nil(err)
+			assert.Equal("foo", name)
+
+			version, err := client.Version()
+			assert.Nil(err)
+			assert.Equal("1.2.3", version)
+
+			capabilities, err := client.Capabilities()
+			assert.Nil(err)
+			assert.ElementsMatch(tt.capabilities, capabilities)
+
+			resp, err := client.Handshake()
+			assert.Nil(err)
+			assert.Equal(protocolVersions(), resp.GetProtocolVersions())
+		})
+	}
+}
+
+func TestGRPCExecute(t *testing.T) {
+	tests := map[string]struct {
+		printer  printFunc
+		expected string
+		wantErr  string
+	}{
+		"Success": {
+			printer: func(config *print.Config, module *terraform.Module) (string, error) {
+				return config.Content + ":" + module.Inputs[0].Name, nil
+			},
+			expected: "{{ .Inputs }}:foo",
+		},
+		"PrinterError": {
+			printer: func(*print.Config, *terraform.Module) (string, error) {
+				return "", errors.New("something went wrong")
+			},
+			wantErr: "something went wrong",
+		},
+		"NotFormatter": {
+			printer: nil,
+			wantErr: "plugin 'foo' doesn't have 'formatter' capability",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			client := dispenseGRPC(t, newFormatter(&ServeOpts{Name: "foo", Version: "1.2.3", Printer: tt.printer}))
+
+			config := print.DefaultConfig()
+			config.Content = "{{ .Inputs }}"
+
+			module := &terraform.Module{
+				Inputs: []*terraform.Input{{Name: "foo"}},
+			}
+
+			actual, err := client.Execute(&ExecuteArgs{Module: module, Config: config})
+
+			if tt.wantErr != "" {
+				assert.EqualError(err, tt.wantErr)
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGRPCExecuteRegistry(t *testing.T) {
+	assert := assert.New(t)
+
+	printer := func(_ *print.Config, module *terraform.Module) (string, error) {
+		return module.Resources[0].URL(), nil
+	}
+	client := dispenseGRPC(t, newFormatter(&ServeOpts{Name: "foo", Version: "1.2.3", Printer: printer}))
+
+	config := print.DefaultConfig()
+	config.Registry.Host = "registry.opentofu.org"
+
+	module := &terraform.Module{
+		Resources: []*terraform.Resource{
+			{
+				Type:           "instance",
+				Name:           "this",
+				ProviderName:   "aws",
+				ProviderSource: "hashicorp/aws",
+				Mode:           "managed",
+				Version:        "5.31.0",
+			},
+		},
+	}
+
+	actual, err := client.Execute(&ExecuteArgs{Module: module, Config: config})
+	assert.Nil(err)
+	assert.Equal("https://search.opentofu.org/provider/hashicorp/aws/5.31.0/docs/resources/instance", actual)
+}
+
+func TestGRPCEnrich(t *testing.T) {
+	tests := map[string]struct {
+		enricher enrichFunc
+		expected string
+		wantErr  string
+	}{
+		"Success": {
+			enricher: func(_ *print.Config, module *terraform.Module) (*terraform.Module, error) {
+				module.Inputs[0].Description = "estimated cost: $10"
+				return module, nil
+			},
+			expected: "estimated cost: $10",
+		},
+		"EnricherError": {
+			enricher: func(*print.Config, *terraform.Module) (*terraform.Module, error) {
+				return nil, errors.New("something went wrong")
+			},
+			wantErr: "something went wrong",
+		},
+		"NotEnricher": {
+			enricher: nil,
+			wantErr:  "plugin 'foo' doesn't have 'enricher' capability",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			client := dispenseGRPC(t, newFormatter(&ServeOpts{Name: "foo", Version: "1.2.3", Enricher: tt.enricher}))
+
+			module := &terraform.Module{
+				Inputs: []*terraform.Input{{Name: "foo"}},
+			}
+
+			actual, err := client.Enrich(&ExecuteArgs{Module: module, Config: print.DefaultConfig()})
+
+			if tt.wantErr != "" {
+				assert.EqualError(err, tt.wantErr)
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, string(actual.Inputs[0].Description))
+			}
+		})
+	}
+}
+
+func TestGRPCPostProcess(t *testing.T) {
+	tests := map[string]struct {
+		postProcessor postProcessFunc
+		expected      string
+		wantErr       string
+	}{
+		"Success": {
+			postProcessor: func(config *print.Config, content string) (string, error) {
+				return content + "\n" + config.Formatter, nil
+			},
+			expected: "foo\nmarkdown",
+		},
+		"PostProcessorError": {
+			postProcessor: func(*print.Config, string) (string, error) {
+				return "", errors.New("something went wrong")
+			},
+			wantErr: "something went wrong",
+		},
+		"NotPostProcessor": {
+			postProcessor: nil,
+			wantErr:       "plugin 'foo' doesn't have 'post-processor' capability",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			client := dispenseGRPC(t, newFormatter(&ServeOpts{Name: "foo", Version: "1.2.3", PostProcessor: tt.postProcessor}))
+
+			config := print.DefaultConfig()
+			config.Formatter = "markdown"
+
+			actual, err := client.PostProcess(&PostProcessArgs{Content: "foo", Config: config})
+
+			if tt.wantErr != "" {
+				assert.EqualError(err, tt.wantErr)
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, actual)
+			}
+		})
+	}
+}
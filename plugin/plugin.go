@@ -12,35 +12,104 @@ package plugin
 
 import (
 	"encoding/gob"
+	"fmt"
 	"net/rpc"
 
 	goplugin "github.com/hashicorp/go-plugin"
 
 	"github.com/terraform-docs/terraform-docs/internal/types"
+	"github.com/terraform-docs/terraform-docs/terraform"
 )
 
 // Ensure formatter fully satisfy plugin interface.
 var _ goplugin.Plugin = &formatter{}
 
+// Protocol versions supported by the plugins. Plugins are served with all of
+// them and the host negotiates the latest one both sides support.
+const (
+	// ProtocolVersionNetRPC is the legacy net/rpc protocol, where the module
+	// and config are gob encoded Go structs.
+	ProtocolVersionNetRPC = 7
+
+	// ProtocolVersionGRPC is the gRPC protocol, where the module and config
+	// are encoded with the stable protobuf schema of plugin/proto.
+	ProtocolVersionGRPC = 8
+)
+
+// Capabilities of plugins, reported to the host by handshake. Enrichers and
+// post-processors are only supported with ProtocolVersionGRPC.
+const (
+	// CapabilityFormatter generates the content of a module.
+	CapabilityFormatter = "formatter"
+
+	// CapabilityEnricher mutates the loaded module before the content is
+	// generated, e.g. to add cost or compliance annotations.
+	CapabilityEnricher = "enricher"
+
+	// CapabilityPostProcessor processes the generated content before it's
+	// written out.
+	CapabilityPostProcessor = "post-processor"
+)
+
 // handshakeConfig is used for UX. ProcotolVersion will be updated by incompatible changes.
 var handshakeConfig = goplugin.HandshakeConfig{
-	ProtocolVersion:  7,
+	ProtocolVersion:  ProtocolVersionNetRPC,
 	MagicCookieKey:   "TFDOCS_PLUGIN",
 	MagicCookieValue: "A7U5oTDDJwdL6UKOw6RXATDa86NEo4xLK3rz7QqegT1N4EY66qb6UeAJDSxLwtXH",
 }
 
-// formatter is a wrapper to satisfy the interface of go-plugin.
+// protocolVersions returns all the supported protocol versions.
+func protocolVersions() []uint32 {
+	return []uint32{ProtocolVersionNetRPC, ProtocolVersionGRPC}
+}
+
+// Formatter is the host side of a formatter plugin, regardless of the
+// protocol version it's served with.
+type Formatter interface {
+	Name() (string, error)
+	Version() (string, error)
+	Capabilities() ([]string, error)
+	Execute(args *ExecuteArgs) (string, error)
+}
+
+// Enricher is the host side of an enricher plugin.
+type Enricher interface {
+	Name() (string, error)
+	Enrich(args *ExecuteArgs) (*terraform.Module, error)
+}
+
+// PostProcessor is the host side of a post-processor plugin.
+type PostProcessor interface {
+	Name() (string, error)
+	PostProcess(args *PostProcessArgs) (string, error)
+}
+
+// Ensure clients of all protocol versions fully satisfy Formatter interface,
+// and the ones supporting them satisfy Enricher and PostProcessor interfaces.
+var (
+	_ Formatter     = &Client{}
+	_ Formatter     = &GRPCClient{}
+	_ Enricher      = &GRPCClient{}
+	_ PostProcessor = &GRPCClient{}
+)
+
+// formatter is a wrapper to satisfy the interface of go-plugin. Despite its
+// name, it carries all the capabilities of the plugin.
 type formatter struct {
-	name    string
-	version string
-	printer printFunc
+	name          string
+	version       string
+	printer       printFunc
+	enricher      enrichFunc
+	postProcessor postProcessFunc
 }
 
-func newFormatter(name string, version string, printer printFunc) *formatter {
+func newFormatter(opts *ServeOpts) *formatter {
 	return &formatter{
-		name:    name,
-		version: version,
-		printer: printer,
+		name:          opts.Name,
+		version:       opts.Version,
+		printer:       opts.Printer,
+		enricher:      opts.Enricher,
+		postProcessor: opts.PostProcessor,
 	}
 }
 
@@ -52,10 +121,49 @@ func (f *formatter) Version() string {
 	return f.version
 }
 
+func (f *formatter) Capabilities() []string {
+	capabilities := make([]string, 0)
+	if f.printer != nil {
+		capabilities = append(capabilities, CapabilityFormatter)
+	}
+	if f.enricher != nil {
+		capabilities = append(capabilities, CapabilityEnricher)
+	}
+	if f.postProcessor != nil {
+		capabilities = append(capabilities, CapabilityPostProcessor)
+	}
+	return capabilities
+}
+
 func (f *formatter) Execute(args *ExecuteArgs) (string, error) {
+	if f.printer == nil {
+		return "", f.missingCapability(CapabilityFormatter)
+	}
 	return f.printer(args.Config, args.Module)
 }
 
+func (f *formatter) Enrich(args *ExecuteArgs) (*terraform.Module, error) {
+	if f.enricher == nil {
+		return nil, f.missingCapability(CapabilityEnricher)
+	}
+	return f.enricher(args.Config, args.Module)
+}
+
+func (f *formatter) PostProcess(args *PostProcessArgs) (string, error) {
+	if f.postProcessor == nil {
+		return "", f.missingCapability(CapabilityPostProcessor)
+	}
+	return f.postProcessor(args.Config, args.Content)
+}
+
+func (f *formatter) missingCapability(capability string) error {
+	return missingCapabilityError(f.name, capability)
+}
+
+func missingCapabilityError(name string, capability string) error {
+	return fmt.Errorf("plugin '%s' doesn't have '%s' capability", name, capability)
+}
+
 // Server returns an RPC server acting as a plugin.
 func (f *formatter) Server(b *goplugin.MuxBroker) (interface{}, error) {
 	return &Server{impl: f, broker: b}, nil
@@ -66,6 +174,14 @@ func (*formatter) Client(b *goplugin.MuxBroker, c *rpc.Client) (interface{}, err
 	return &Client{rpcClient: c, broker: b}, nil
 }
 
+// pluginSets returns the plugins of 'f' for each of the protocol versions.
+func pluginSets(f *formatter) map[int]goplugin.PluginSet {
+	return map[int]goplugin.PluginSet{
+		ProtocolVersionNetRPC: {"formatter": f},
+		ProtocolVersionGRPC:   {"formatter": &grpcFormatter{impl: f}},
+	}
+}
+
 func init() {
 	gob.Register(new(types.Bool))
 	gob.Register(new(types.Empty))
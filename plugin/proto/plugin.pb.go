@@ -0,0 +1,3072 @@
+// Copyright 2021 The terraform-docs Authors.
+//
+// Licensed under the MIT license (the "License"); you may not
+// use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at the LICENSE file in
+// the root directory of this source tree.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: plugin/proto/plugin.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Handshake struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Handshake) Reset() {
+	*x = Handshake{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Handshake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Handshake) ProtoMessage() {}
+
+func (x *Handshake) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Handshake.ProtoReflect.Descriptor instead.
+func (*Handshake) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{0}
+}
+
+type Execute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Execute) Reset() {
+	*x = Execute{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Execute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Execute) ProtoMessage() {}
+
+func (x *Execute) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Execute.ProtoReflect.Descriptor instead.
+func (*Execute) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{1}
+}
+
+type Enrich struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Enrich) Reset() {
+	*x = Enrich{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Enrich) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enrich) ProtoMessage() {}
+
+func (x *Enrich) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enrich.ProtoReflect.Descriptor instead.
+func (*Enrich) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{2}
+}
+
+type PostProcess struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostProcess) Reset() {
+	*x = PostProcess{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostProcess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostProcess) ProtoMessage() {}
+
+func (x *PostProcess) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostProcess.ProtoReflect.Descriptor instead.
+func (*PostProcess) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{3}
+}
+
+// Module represents a Terraform module.
+type Module struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Header        string                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Footer        string                 `protobuf:"bytes,2,opt,name=footer,proto3" json:"footer,omitempty"`
+	Inputs        []*Input               `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	ModuleCalls   []*ModuleCall          `protobuf:"bytes,4,rep,name=module_calls,json=moduleCalls,proto3" json:"module_calls,omitempty"`
+	Outputs       []*Output              `protobuf:"bytes,5,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	Providers     []*Provider            `protobuf:"bytes,6,rep,name=providers,proto3" json:"providers,omitempty"`
+	Requirements  []*Requirement         `protobuf:"bytes,7,rep,name=requirements,proto3" json:"requirements,omitempty"`
+	Resources     []*Resource            `protobuf:"bytes,8,rep,name=resources,proto3" json:"resources,omitempty"`
+	Checks        []*Check               `protobuf:"bytes,9,rep,name=checks,proto3" json:"checks,omitempty"`
+	Migrations    []*Migration           `protobuf:"bytes,10,rep,name=migrations,proto3" json:"migrations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Module) Reset() {
+	*x = Module{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Module) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Module) ProtoMessage() {}
+
+func (x *Module) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Module.ProtoReflect.Descriptor instead.
+func (*Module) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Module) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *Module) GetFooter() string {
+	if x != nil {
+		return x.Footer
+	}
+	return ""
+}
+
+func (x *Module) GetInputs() []*Input {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *Module) GetModuleCalls() []*ModuleCall {
+	if x != nil {
+		return x.ModuleCalls
+	}
+	return nil
+}
+
+func (x *Module) GetOutputs() []*Output {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *Module) GetProviders() []*Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *Module) GetRequirements() []*Requirement {
+	if x != nil {
+		return x.Requirements
+	}
+	return nil
+}
+
+func (x *Module) GetResources() []*Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *Module) GetChecks() []*Check {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *Module) GetMigrations() []*Migration {
+	if x != nil {
+		return x.Migrations
+	}
+	return nil
+}
+
+// Position represents position of an item in a file.
+type Position struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Line          int64                  `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Position) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Position) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+// Input represents a Terraform input. Values are JSON encoded, and are empty
+// if they are not set at all.
+type Input struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	TypeSchema    *TypeSchema            `protobuf:"bytes,3,opt,name=type_schema,json=typeSchema,proto3" json:"type_schema,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Default       []byte                 `protobuf:"bytes,5,opt,name=default,proto3" json:"default,omitempty"`
+	Required      bool                   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
+	Sensitive     bool                   `protobuf:"varint,7,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	Nullable      bool                   `protobuf:"varint,8,opt,name=nullable,proto3" json:"nullable,omitempty"`
+	Ephemeral     bool                   `protobuf:"varint,9,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
+	Validations   []*Validation          `protobuf:"bytes,10,rep,name=validations,proto3" json:"validations,omitempty"`
+	Position      *Position              `protobuf:"bytes,11,opt,name=position,proto3" json:"position,omitempty"`
+	Example       []byte                 `protobuf:"bytes,12,opt,name=example,proto3" json:"example,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Input) Reset() {
+	*x = Input{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Input) ProtoMessage() {}
+
+func (x *Input) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Input.ProtoReflect.Descriptor instead.
+func (*Input) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Input) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Input) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Input) GetTypeSchema() *TypeSchema {
+	if x != nil {
+		return x.TypeSchema
+	}
+	return nil
+}
+
+func (x *Input) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Input) GetDefault() []byte {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *Input) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *Input) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+func (x *Input) GetNullable() bool {
+	if x != nil {
+		return x.Nullable
+	}
+	return false
+}
+
+func (x *Input) GetEphemeral() bool {
+	if x != nil {
+		return x.Ephemeral
+	}
+	return false
+}
+
+func (x *Input) GetValidations() []*Validation {
+	if x != nil {
+		return x.Validations
+	}
+	return nil
+}
+
+func (x *Input) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *Input) GetExample() []byte {
+	if x != nil {
+		return x.Example
+	}
+	return nil
+}
+
+// Validation represents a validation rule of a Terraform input.
+type Validation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Condition     string                 `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Validation) Reset() {
+	*x = Validation{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Validation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Validation) ProtoMessage() {}
+
+func (x *Validation) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Validation.ProtoReflect.Descriptor instead.
+func (*Validation) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Validation) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *Validation) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// TypeSchema represents the structured type constraint of a Terraform input.
+type TypeSchema struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Element       *TypeSchema            `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+	Elements      []*TypeSchema          `protobuf:"bytes,3,rep,name=elements,proto3" json:"elements,omitempty"`
+	Attributes    []*TypeAttribute       `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypeSchema) Reset() {
+	*x = TypeSchema{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypeSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeSchema) ProtoMessage() {}
+
+func (x *TypeSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeSchema.ProtoReflect.Descriptor instead.
+func (*TypeSchema) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TypeSchema) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *TypeSchema) GetElement() *TypeSchema {
+	if x != nil {
+		return x.Element
+	}
+	return nil
+}
+
+func (x *TypeSchema) GetElements() []*TypeSchema {
+	if x != nil {
+		return x.Elements
+	}
+	return nil
+}
+
+func (x *TypeSchema) GetAttributes() []*TypeAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// TypeAttribute represents an attribute of an object type constraint.
+type TypeAttribute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Type          *TypeSchema            `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Optional      bool                   `protobuf:"varint,4,opt,name=optional,proto3" json:"optional,omitempty"`
+	Default       []byte                 `protobuf:"bytes,5,opt,name=default,proto3" json:"default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypeAttribute) Reset() {
+	*x = TypeAttribute{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypeAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeAttribute) ProtoMessage() {}
+
+func (x *TypeAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeAttribute.ProtoReflect.Descriptor instead.
+func (*TypeAttribute) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TypeAttribute) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TypeAttribute) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TypeAttribute) GetType() *TypeSchema {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *TypeAttribute) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+func (x *TypeAttribute) GetDefault() []byte {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+// ModuleCall represents a submodule called by Terraform module.
+type ModuleCall struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Source      string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Version     string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Position    *Position              `protobuf:"bytes,5,opt,name=position,proto3" json:"position,omitempty"`
+	// Address and nested items are only set for local modules with
+	// 'settings.nested-resources'.
+	MetaArgument  string        `protobuf:"bytes,6,opt,name=meta_argument,json=metaArgument,proto3" json:"meta_argument,omitempty"`
+	Address       string        `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	Resources     []*Resource   `protobuf:"bytes,8,rep,name=resources,proto3" json:"resources,omitempty"`
+	ModuleCalls   []*ModuleCall `protobuf:"bytes,9,rep,name=module_calls,json=moduleCalls,proto3" json:"module_calls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleCall) Reset() {
+	*x = ModuleCall{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleCall) ProtoMessage() {}
+
+func (x *ModuleCall) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleCall.ProtoReflect.Descriptor instead.
+func (*ModuleCall) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ModuleCall) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ModuleCall) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ModuleCall) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ModuleCall) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ModuleCall) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *ModuleCall) GetMetaArgument() string {
+	if x != nil {
+		return x.MetaArgument
+	}
+	return ""
+}
+
+func (x *ModuleCall) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ModuleCall) GetResources() []*Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ModuleCall) GetModuleCalls() []*ModuleCall {
+	if x != nil {
+		return x.ModuleCalls
+	}
+	return nil
+}
+
+// Output represents a Terraform output.
+type Output struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Value         []byte                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Sensitive     bool                   `protobuf:"varint,4,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	ShowValue     bool                   `protobuf:"varint,5,opt,name=show_value,json=showValue,proto3" json:"show_value,omitempty"`
+	Position      *Position              `protobuf:"bytes,6,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Output) Reset() {
+	*x = Output{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Output) ProtoMessage() {}
+
+func (x *Output) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Output.ProtoReflect.Descriptor instead.
+func (*Output) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Output) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Output) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Output) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Output) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+func (x *Output) GetShowValue() bool {
+	if x != nil {
+		return x.ShowValue
+	}
+	return false
+}
+
+func (x *Output) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+// Provider represents a Terraform provider.
+type Provider struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Alias         string                 `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Position      *Position              `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	Source        string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	Constraint    string                 `protobuf:"bytes,6,opt,name=constraint,proto3" json:"constraint,omitempty"`
+	Locked        string                 `protobuf:"bytes,7,opt,name=locked,proto3" json:"locked,omitempty"`
+	Hashes        []string               `protobuf:"bytes,8,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Provider) Reset() {
+	*x = Provider{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Provider) ProtoMessage() {}
+
+func (x *Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
+func (*Provider) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Provider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Provider) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *Provider) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Provider) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *Provider) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Provider) GetConstraint() string {
+	if x != nil {
+		return x.Constraint
+	}
+	return ""
+}
+
+func (x *Provider) GetLocked() string {
+	if x != nil {
+		return x.Locked
+	}
+	return ""
+}
+
+func (x *Provider) GetHashes() []string {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+// Requirement represents a requirement for Terraform module.
+type Requirement struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version              string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Source               string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	ConfigurationAliases []string               `protobuf:"bytes,4,rep,name=configuration_aliases,json=configurationAliases,proto3" json:"configuration_aliases,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Requirement) Reset() {
+	*x = Requirement{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Requirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Requirement) ProtoMessage() {}
+
+func (x *Requirement) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Requirement.ProtoReflect.Descriptor instead.
+func (*Requirement) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Requirement) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Requirement) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Requirement) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Requirement) GetConfigurationAliases() []string {
+	if x != nil {
+		return x.ConfigurationAliases
+	}
+	return nil
+}
+
+// Resource represents a managed or data resource used by the module.
+type Resource struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Type           string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ProviderName   string                 `protobuf:"bytes,3,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
+	ProviderSource string                 `protobuf:"bytes,4,opt,name=provider_source,json=providerSource,proto3" json:"provider_source,omitempty"`
+	Mode           string                 `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	Version        string                 `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	Description    string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Position       *Position              `protobuf:"bytes,8,opt,name=position,proto3" json:"position,omitempty"`
+	MetaArgument   string                 `protobuf:"bytes,9,opt,name=meta_argument,json=metaArgument,proto3" json:"meta_argument,omitempty"`
+	Address        string                 `protobuf:"bytes,10,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Resource) Reset() {
+	*x = Resource{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resource) ProtoMessage() {}
+
+func (x *Resource) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
+func (*Resource) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Resource) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Resource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Resource) GetProviderName() string {
+	if x != nil {
+		return x.ProviderName
+	}
+	return ""
+}
+
+func (x *Resource) GetProviderSource() string {
+	if x != nil {
+		return x.ProviderSource
+	}
+	return ""
+}
+
+func (x *Resource) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Resource) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Resource) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Resource) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *Resource) GetMetaArgument() string {
+	if x != nil {
+		return x.MetaArgument
+	}
+	return ""
+}
+
+func (x *Resource) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// Check represents an assertion of the module.
+type Check struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Owner         string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Condition     string                 `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	File          string                 `protobuf:"bytes,5,opt,name=file,proto3" json:"file,omitempty"`
+	Line          int64                  `protobuf:"varint,6,opt,name=line,proto3" json:"line,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Check) Reset() {
+	*x = Check{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Check) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Check) ProtoMessage() {}
+
+func (x *Check) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Check.ProtoReflect.Descriptor instead.
+func (*Check) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Check) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Check) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Check) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *Check) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *Check) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *Check) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+// Migration represents a 'moved', 'removed' or 'import' block of the module.
+type Migration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Id            string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	File          string                 `protobuf:"bytes,6,opt,name=file,proto3" json:"file,omitempty"`
+	Line          int64                  `protobuf:"varint,7,opt,name=line,proto3" json:"line,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Migration) Reset() {
+	*x = Migration{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Migration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Migration) ProtoMessage() {}
+
+func (x *Migration) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Migration.ProtoReflect.Descriptor instead.
+func (*Migration) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Migration) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Migration) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Migration) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Migration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Migration) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Migration) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *Migration) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+// Config represents the configuration used to generate the content.
+type Config struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Formatter     string                 `protobuf:"bytes,1,opt,name=formatter,proto3" json:"formatter,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	HeaderFrom    string                 `protobuf:"bytes,3,opt,name=header_from,json=headerFrom,proto3" json:"header_from,omitempty"`
+	FooterFrom    string                 `protobuf:"bytes,4,opt,name=footer_from,json=footerFrom,proto3" json:"footer_from,omitempty"`
+	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Sections      *Config_Sections       `protobuf:"bytes,6,opt,name=sections,proto3" json:"sections,omitempty"`
+	Output        *Config_Output         `protobuf:"bytes,7,opt,name=output,proto3" json:"output,omitempty"`
+	OutputValues  *Config_OutputValues   `protobuf:"bytes,8,opt,name=output_values,json=outputValues,proto3" json:"output_values,omitempty"`
+	Sort          *Config_Sort           `protobuf:"bytes,9,opt,name=sort,proto3" json:"sort,omitempty"`
+	Settings      *Config_Settings       `protobuf:"bytes,10,opt,name=settings,proto3" json:"settings,omitempty"`
+	ModuleRoot    string                 `protobuf:"bytes,11,opt,name=module_root,json=moduleRoot,proto3" json:"module_root,omitempty"`
+	ExampleValues *Config_ExampleValues  `protobuf:"bytes,12,opt,name=example_values,json=exampleValues,proto3" json:"example_values,omitempty"`
+	Usage         *Config_Usage          `protobuf:"bytes,13,opt,name=usage,proto3" json:"usage,omitempty"`
+	Registry      *Config_Registry       `protobuf:"bytes,14,opt,name=registry,proto3" json:"registry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Config) GetFormatter() string {
+	if x != nil {
+		return x.Formatter
+	}
+	return ""
+}
+
+func (x *Config) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Config) GetHeaderFrom() string {
+	if x != nil {
+		return x.HeaderFrom
+	}
+	return ""
+}
+
+func (x *Config) GetFooterFrom() string {
+	if x != nil {
+		return x.FooterFrom
+	}
+	return ""
+}
+
+func (x *Config) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Config) GetSections() *Config_Sections {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *Config) GetOutput() *Config_Output {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *Config) GetOutputValues() *Config_OutputValues {
+	if x != nil {
+		return x.OutputValues
+	}
+	return nil
+}
+
+func (x *Config) GetSort() *Config_Sort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *Config) GetSettings() *Config_Settings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *Config) GetModuleRoot() string {
+	if x != nil {
+		return x.ModuleRoot
+	}
+	return ""
+}
+
+func (x *Config) GetExampleValues() *Config_ExampleValues {
+	if x != nil {
+		return x.ExampleValues
+	}
+	return nil
+}
+
+func (x *Config) GetUsage() *Config_Usage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *Config) GetRegistry() *Config_Registry {
+	if x != nil {
+		return x.Registry
+	}
+	return nil
+}
+
+type Handshake_Request struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version of terraform-docs.
+	HostVersion string `protobuf:"bytes,1,opt,name=host_version,json=hostVersion,proto3" json:"host_version,omitempty"`
+	// Protocol versions supported by terraform-docs.
+	ProtocolVersions []uint32 `protobuf:"varint,2,rep,packed,name=protocol_versions,json=protocolVersions,proto3" json:"protocol_versions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Handshake_Request) Reset() {
+	*x = Handshake_Request{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Handshake_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Handshake_Request) ProtoMessage() {}
+
+func (x *Handshake_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Handshake_Request.ProtoReflect.Descriptor instead.
+func (*Handshake_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Handshake_Request) GetHostVersion() string {
+	if x != nil {
+		return x.HostVersion
+	}
+	return ""
+}
+
+func (x *Handshake_Request) GetProtocolVersions() []uint32 {
+	if x != nil {
+		return x.ProtocolVersions
+	}
+	return nil
+}
+
+type Handshake_Response struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Protocol versions supported by the plugin.
+	ProtocolVersions []uint32 `protobuf:"varint,3,rep,packed,name=protocol_versions,json=protocolVersions,proto3" json:"protocol_versions,omitempty"`
+	// Capabilities of the plugin, i.e. "formatter", "enricher" and
+	// "post-processor".
+	Capabilities  []string `protobuf:"bytes,4,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Handshake_Response) Reset() {
+	*x = Handshake_Response{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Handshake_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Handshake_Response) ProtoMessage() {}
+
+func (x *Handshake_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Handshake_Response.ProtoReflect.Descriptor instead.
+func (*Handshake_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Handshake_Response) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Handshake_Response) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Handshake_Response) GetProtocolVersions() []uint32 {
+	if x != nil {
+		return x.ProtocolVersions
+	}
+	return nil
+}
+
+func (x *Handshake_Response) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+type Execute_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Module        *Module                `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Config        *Config                `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Execute_Request) Reset() {
+	*x = Execute_Request{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Execute_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Execute_Request) ProtoMessage() {}
+
+func (x *Execute_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Execute_Request.ProtoReflect.Descriptor instead.
+func (*Execute_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Execute_Request) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *Execute_Request) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type Execute_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Execute_Response) Reset() {
+	*x = Execute_Response{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Execute_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Execute_Response) ProtoMessage() {}
+
+func (x *Execute_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Execute_Response.ProtoReflect.Descriptor instead.
+func (*Execute_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *Execute_Response) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type Enrich_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Module        *Module                `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Config        *Config                `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Enrich_Request) Reset() {
+	*x = Enrich_Request{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Enrich_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enrich_Request) ProtoMessage() {}
+
+func (x *Enrich_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enrich_Request.ProtoReflect.Descriptor instead.
+func (*Enrich_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Enrich_Request) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *Enrich_Request) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type Enrich_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Module        *Module                `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Enrich_Response) Reset() {
+	*x = Enrich_Response{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Enrich_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enrich_Response) ProtoMessage() {}
+
+func (x *Enrich_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enrich_Response.ProtoReflect.Descriptor instead.
+func (*Enrich_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *Enrich_Response) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+type PostProcess_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Config        *Config                `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostProcess_Request) Reset() {
+	*x = PostProcess_Request{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostProcess_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostProcess_Request) ProtoMessage() {}
+
+func (x *PostProcess_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostProcess_Request.ProtoReflect.Descriptor instead.
+func (*PostProcess_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *PostProcess_Request) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PostProcess_Request) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type PostProcess_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostProcess_Response) Reset() {
+	*x = PostProcess_Response{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostProcess_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostProcess_Response) ProtoMessage() {}
+
+func (x *PostProcess_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostProcess_Response.ProtoReflect.Descriptor instead.
+func (*PostProcess_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *PostProcess_Response) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type Config_Sections struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Show          []string               `protobuf:"bytes,1,rep,name=show,proto3" json:"show,omitempty"`
+	Hide          []string               `protobuf:"bytes,2,rep,name=hide,proto3" json:"hide,omitempty"`
+	Checks        bool                   `protobuf:"varint,3,opt,name=checks,proto3" json:"checks,omitempty"`
+	DataSources   bool                   `protobuf:"varint,4,opt,name=data_sources,json=dataSources,proto3" json:"data_sources,omitempty"`
+	Header        bool                   `protobuf:"varint,5,opt,name=header,proto3" json:"header,omitempty"`
+	Footer        bool                   `protobuf:"varint,6,opt,name=footer,proto3" json:"footer,omitempty"`
+	Inputs        bool                   `protobuf:"varint,7,opt,name=inputs,proto3" json:"inputs,omitempty"`
+	Migrations    bool                   `protobuf:"varint,8,opt,name=migrations,proto3" json:"migrations,omitempty"`
+	ModuleCalls   bool                   `protobuf:"varint,9,opt,name=module_calls,json=moduleCalls,proto3" json:"module_calls,omitempty"`
+	Outputs       bool                   `protobuf:"varint,10,opt,name=outputs,proto3" json:"outputs,omitempty"`
+	Providers     bool                   `protobuf:"varint,11,opt,name=providers,proto3" json:"providers,omitempty"`
+	Requirements  bool                   `protobuf:"varint,12,opt,name=requirements,proto3" json:"requirements,omitempty"`
+	Resources     bool                   `protobuf:"varint,13,opt,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config_Sections) Reset() {
+	*x = Config_Sections{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config_Sections) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Sections) ProtoMessage() {}
+
+func (x *Config_Sections) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Sections.ProtoReflect.Descriptor instead.
+func (*Config_Sections) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *Config_Sections) GetShow() []string {
+	if x != nil {
+		return x.Show
+	}
+	return nil
+}
+
+func (x *Config_Sections) GetHide() []string {
+	if x != nil {
+		return x.Hide
+	}
+	return nil
+}
+
+func (x *Config_Sections) GetChecks() bool {
+	if x != nil {
+		return x.Checks
+	}
+	return false
+}
+
+func (x *Config_Sections) GetDataSources() bool {
+	if x != nil {
+		return x.DataSources
+	}
+	return false
+}
+
+func (x *Config_Sections) GetHeader() bool {
+	if x != nil {
+		return x.Header
+	}
+	return false
+}
+
+func (x *Config_Sections) GetFooter() bool {
+	if x != nil {
+		return x.Footer
+	}
+	return false
+}
+
+func (x *Config_Sections) GetInputs() bool {
+	if x != nil {
+		return x.Inputs
+	}
+	return false
+}
+
+func (x *Config_Sections) GetMigrations() bool {
+	if x != nil {
+		return x.Migrations
+	}
+	return false
+}
+
+func (x *Config_Sections) GetModuleCalls() bool {
+	if x != nil {
+		return x.ModuleCalls
+	}
+	return false
+}
+
+func (x *Config_Sections) GetOutputs() bool {
+	if x != nil {
+		return x.Outputs
+	}
+	return false
+}
+
+func (x *Config_Sections) GetProviders() bool {
+	if x != nil {
+		return x.Providers
+	}
+	return false
+}
+
+func (x *Config_Sections) GetRequirements() bool {
+	if x != nil {
+		return x.Requirements
+	}
+	return false
+}
+
+func (x *Config_Sections) GetResources() bool {
+	if x != nil {
+		return x.Resources
+	}
+	return false
+}
+
+type Config_Output struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Template      string                 `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	Check         bool                   `protobuf:"varint,4,opt,name=check,proto3" json:"check,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config_Output) Reset() {
+	*x = Config_Output{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Output) ProtoMessage() {}
+
+func (x *Config_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Output.ProtoReflect.Descriptor instead.
+func (*Config_Output) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{17, 1}
+}
+
+func (x *Config_Output) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *Config_Output) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Config_Output) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *Config_Output) GetCheck() bool {
+	if x != nil {
+		return x.Check
+	}
+	return false
+}
+
+type Config_OutputValues struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config_OutputValues) Reset() {
+	*x = Config_OutputValues{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config_OutputValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_OutputValues) ProtoMessage() {}
+
+func (x *Config_OutputValues) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_OutputValues.ProtoReflect.Descriptor instead.
+func (*Config_OutputValues) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{17, 2}
+}
+
+func (x *Config_OutputValues) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Config_OutputValues) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+type Config_ExampleValues struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	From          []string               `protobuf:"bytes,2,rep,name=from,proto3" json:"from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config_ExampleValues) Reset() {
+	*x = Config_ExampleValues{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config_ExampleValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_ExampleValues) ProtoMessage() {}
+
+func (x *Config_ExampleValues) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_ExampleValues.ProtoReflect.Descriptor instead.
+func (*Config_ExampleValues) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{17, 3}
+}
+
+func (x *Config_ExampleValues) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Config_ExampleValues) GetFrom() []string {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+type Config_Usage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config_Usage) Reset() {
+	*x = Config_Usage{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config_Usage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Usage) ProtoMessage() {}
+
+func (x *Config_Usage) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Usage.ProtoReflect.Descriptor instead.
+func (*Config_Usage) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{17, 4}
+}
+
+func (x *Config_Usage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Config_Usage) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Config_Usage) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type Config_Registry struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Host          string                      `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Hosts         []*Config_Registry_Host     `protobuf:"bytes,2,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	Providers     []*Config_Registry_Provider `protobuf:"bytes,3,rep,name=providers,proto3" json:"providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config_Registry) Reset() {
+	*x = Config_Registry{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config_Registry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Registry) ProtoMessage() {}
+
+func (x *Config_Registry) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Registry.ProtoReflect.Descriptor instead.
+func (*Config_Registry) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{17, 5}
+}
+
+func (x *Config_Registry) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Config_Registry) GetHosts() []*Config_Registry_Host {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *Config_Registry) GetProviders() []*Config_Registry_Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+type Config_Sort struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	By            string                 `protobuf:"bytes,2,opt,name=by,proto3" json:"by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config_Sort) Reset() {
+	*x = Config_Sort{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config_Sort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Sort) ProtoMessage() {}
+
+func (x *Config_Sort) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Sort.ProtoReflect.Descriptor instead.
+func (*Config_Sort) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{17, 6}
+}
+
+func (x *Config_Sort) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Config_Sort) GetBy() string {
+	if x != nil {
+		return x.By
+	}
+	return ""
+}
+
+type Config_Settings struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Anchor          bool                   `protobuf:"varint,1,opt,name=anchor,proto3" json:"anchor,omitempty"`
+	Attributes      bool                   `protobuf:"varint,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	AtxClosed       bool                   `protobuf:"varint,3,opt,name=atx_closed,json=atxClosed,proto3" json:"atx_closed,omitempty"`
+	Color           bool                   `protobuf:"varint,4,opt,name=color,proto3" json:"color,omitempty"`
+	Default         bool                   `protobuf:"varint,5,opt,name=default,proto3" json:"default,omitempty"`
+	Description     bool                   `protobuf:"varint,6,opt,name=description,proto3" json:"description,omitempty"`
+	Ephemeral       bool                   `protobuf:"varint,7,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
+	Escape          bool                   `protobuf:"varint,8,opt,name=escape,proto3" json:"escape,omitempty"`
+	HideEmpty       bool                   `protobuf:"varint,9,opt,name=hide_empty,json=hideEmpty,proto3" json:"hide_empty,omitempty"`
+	Html            bool                   `protobuf:"varint,10,opt,name=html,proto3" json:"html,omitempty"`
+	Indent          int64                  `protobuf:"varint,11,opt,name=indent,proto3" json:"indent,omitempty"`
+	Lockfile        bool                   `protobuf:"varint,12,opt,name=lockfile,proto3" json:"lockfile,omitempty"`
+	Nullable        bool                   `protobuf:"varint,13,opt,name=nullable,proto3" json:"nullable,omitempty"`
+	ReadComments    bool                   `protobuf:"varint,14,opt,name=read_comments,json=readComments,proto3" json:"read_comments,omitempty"`
+	Required        bool                   `protobuf:"varint,15,opt,name=required,proto3" json:"required,omitempty"`
+	Sensitive       bool                   `protobuf:"varint,16,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	Type            bool                   `protobuf:"varint,17,opt,name=type,proto3" json:"type,omitempty"`
+	Validation      bool                   `protobuf:"varint,18,opt,name=validation,proto3" json:"validation,omitempty"`
+	NestedResources bool                   `protobuf:"varint,19,opt,name=nested_resources,json=nestedResources,proto3" json:"nested_resources,omitempty"`
+	Source          bool                   `protobuf:"varint,20,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Config_Settings) Reset() {
+	*x = Config_Settings{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config_Settings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Settings) ProtoMessage() {}
+
+func (x *Config_Settings) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Settings.ProtoReflect.Descriptor instead.
+func (*Config_Settings) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{17, 7}
+}
+
+func (x *Config_Settings) GetAnchor() bool {
+	if x != nil {
+		return x.Anchor
+	}
+	return false
+}
+
+func (x *Config_Settings) GetAttributes() bool {
+	if x != nil {
+		return x.Attributes
+	}
+	return false
+}
+
+func (x *Config_Settings) GetAtxClosed() bool {
+	if x != nil {
+		return x.AtxClosed
+	}
+	return false
+}
+
+func (x *Config_Settings) GetColor() bool {
+	if x != nil {
+		return x.Color
+	}
+	return false
+}
+
+func (x *Config_Settings) GetDefault() bool {
+	if x != nil {
+		return x.Default
+	}
+	return false
+}
+
+func (x *Config_Settings) GetDescription() bool {
+	if x != nil {
+		return x.Description
+	}
+	return false
+}
+
+func (x *Config_Settings) GetEphemeral() bool {
+	if x != nil {
+		return x.Ephemeral
+	}
+	return false
+}
+
+func (x *Config_Settings) GetEscape() bool {
+	if x != nil {
+		return x.Escape
+	}
+	return false
+}
+
+func (x *Config_Settings) GetHideEmpty() bool {
+	if x != nil {
+		return x.HideEmpty
+	}
+	return false
+}
+
+func (x *Config_Settings) GetHtml() bool {
+	if x != nil {
+		return x.Html
+	}
+	return false
+}
+
+func (x *Config_Settings) GetIndent() int64 {
+	if x != nil {
+		return x.Indent
+	}
+	return 0
+}
+
+func (x *Config_Settings) GetLockfile() bool {
+	if x != nil {
+		return x.Lockfile
+	}
+	return false
+}
+
+func (x *Config_Settings) GetNullable() bool {
+	if x != nil {
+		return x.Nullable
+	}
+	return false
+}
+
+func (x *Config_Settings) GetReadComments() bool {
+	if x != nil {
+		return x.ReadComments
+	}
+	return false
+}
+
+func (x *Config_Settings) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *Config_Settings) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+func (x *Config_Settings) GetType() bool {
+	if x != nil {
+		return x.Type
+	}
+	return false
+}
+
+func (x *Config_Settings) GetValidation() bool {
+	if x != nil {
+		return x.Validation
+	}
+	return false
+}
+
+func (x *Config_Settings) GetNestedResources() bool {
+	if x != nil {
+		return x.NestedResources
+	}
+	return false
+}
+
+func (x *Config_Settings) GetSource() bool {
+	if x != nil {
+		return x.Source
+	}
+	return false
+}
+
+type Config_Registry_Host struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Resource      string                 `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config_Registry_Host) Reset() {
+	*x = Config_Registry_Host{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config_Registry_Host) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Registry_Host) ProtoMessage() {}
+
+func (x *Config_Registry_Host) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Registry_Host.ProtoReflect.Descriptor instead.
+func (*Config_Registry_Host) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{17, 5, 0}
+}
+
+func (x *Config_Registry_Host) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Config_Registry_Host) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Config_Registry_Host) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+type Config_Registry_Provider struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Resource      string                 `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config_Registry_Provider) Reset() {
+	*x = Config_Registry_Provider{}
+	mi := &file_plugin_proto_plugin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config_Registry_Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Registry_Provider) ProtoMessage() {}
+
+func (x *Config_Registry_Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_plugin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Registry_Provider.ProtoReflect.Descriptor instead.
+func (*Config_Registry_Provider) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_plugin_proto_rawDescGZIP(), []int{17, 5, 1}
+}
+
+func (x *Config_Registry_Provider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Config_Registry_Provider) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Config_Registry_Provider) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+var File_plugin_proto_plugin_proto protoreflect.FileDescriptor
+
+const file_plugin_proto_plugin_proto_rawDesc = "" +
+	"\n" +
+	"\x19plugin/proto/plugin.proto\x12\x10tfdocs.plugin.v2\"\xf2\x01\n" +
+	"\tHandshake\x1aY\n" +
+	"\aRequest\x12!\n" +
+	"\fhost_version\x18\x01 \x01(\tR\vhostVersion\x12+\n" +
+	"\x11protocol_versions\x18\x02 \x03(\rR\x10protocolVersions\x1a\x89\x01\n" +
+	"\bResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12+\n" +
+	"\x11protocol_versions\x18\x03 \x03(\rR\x10protocolVersions\x12\"\n" +
+	"\fcapabilities\x18\x04 \x03(\tR\fcapabilities\"\x9e\x01\n" +
+	"\aExecute\x1am\n" +
+	"\aRequest\x120\n" +
+	"\x06module\x18\x01 \x01(\v2\x18.tfdocs.plugin.v2.ModuleR\x06module\x120\n" +
+	"\x06config\x18\x02 \x01(\v2\x18.tfdocs.plugin.v2.ConfigR\x06config\x1a$\n" +
+	"\bResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\"\xb5\x01\n" +
+	"\x06Enrich\x1am\n" +
+	"\aRequest\x120\n" +
+	"\x06module\x18\x01 \x01(\v2\x18.tfdocs.plugin.v2.ModuleR\x06module\x120\n" +
+	"\x06config\x18\x02 \x01(\v2\x18.tfdocs.plugin.v2.ConfigR\x06config\x1a<\n" +
+	"\bResponse\x120\n" +
+	"\x06module\x18\x01 \x01(\v2\x18.tfdocs.plugin.v2.ModuleR\x06module\"\x8a\x01\n" +
+	"\vPostProcess\x1aU\n" +
+	"\aRequest\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x120\n" +
+	"\x06config\x18\x02 \x01(\v2\x18.tfdocs.plugin.v2.ConfigR\x06config\x1a$\n" +
+	"\bResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\"\x83\x04\n" +
+	"\x06Module\x12\x16\n" +
+	"\x06header\x18\x01 \x01(\tR\x06header\x12\x16\n" +
+	"\x06footer\x18\x02 \x01(\tR\x06footer\x12/\n" +
+	"\x06inputs\x18\x03 \x03(\v2\x17.tfdocs.plugin.v2.InputR\x06inputs\x12?\n" +
+	"\fmodule_calls\x18\x04 \x03(\v2\x1c.tfdocs.plugin.v2.ModuleCallR\vmoduleCalls\x122\n" +
+	"\aoutputs\x18\x05 \x03(\v2\x18.tfdocs.plugin.v2.OutputR\aoutputs\x128\n" +
+	"\tproviders\x18\x06 \x03(\v2\x1a.tfdocs.plugin.v2.ProviderR\tproviders\x12A\n" +
+	"\frequirements\x18\a \x03(\v2\x1d.tfdocs.plugin.v2.RequirementR\frequirements\x128\n" +
+	"\tresources\x18\b \x03(\v2\x1a.tfdocs.plugin.v2.ResourceR\tresources\x12/\n" +
+	"\x06checks\x18\t \x03(\v2\x17.tfdocs.plugin.v2.CheckR\x06checks\x12;\n" +
+	"\n" +
+	"migrations\x18\n" +
+	" \x03(\v2\x1b.tfdocs.plugin.v2.MigrationR\n" +
+	"migrations\":\n" +
+	"\bPosition\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\x03R\x04line\"\xb0\x03\n" +
+	"\x05Input\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12=\n" +
+	"\vtype_schema\x18\x03 \x01(\v2\x1c.tfdocs.plugin.v2.TypeSchemaR\n" +
+	"typeSchema\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
+	"\adefault\x18\x05 \x01(\fR\adefault\x12\x1a\n" +
+	"\brequired\x18\x06 \x01(\bR\brequired\x12\x1c\n" +
+	"\tsensitive\x18\a \x01(\bR\tsensitive\x12\x1a\n" +
+	"\bnullable\x18\b \x01(\bR\bnullable\x12\x1c\n" +
+	"\tephemeral\x18\t \x01(\bR\tephemeral\x12>\n" +
+	"\vvalidations\x18\n" +
+	" \x03(\v2\x1c.tfdocs.plugin.v2.ValidationR\vvalidations\x126\n" +
+	"\bposition\x18\v \x01(\v2\x1a.tfdocs.plugin.v2.PositionR\bposition\x12\x18\n" +
+	"\aexample\x18\f \x01(\fR\aexample\"O\n" +
+	"\n" +
+	"Validation\x12\x1c\n" +
+	"\tcondition\x18\x01 \x01(\tR\tcondition\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\xd3\x01\n" +
+	"\n" +
+	"TypeSchema\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x126\n" +
+	"\aelement\x18\x02 \x01(\v2\x1c.tfdocs.plugin.v2.TypeSchemaR\aelement\x128\n" +
+	"\belements\x18\x03 \x03(\v2\x1c.tfdocs.plugin.v2.TypeSchemaR\belements\x12?\n" +
+	"\n" +
+	"attributes\x18\x04 \x03(\v2\x1f.tfdocs.plugin.v2.TypeAttributeR\n" +
+	"attributes\"\xad\x01\n" +
+	"\rTypeAttribute\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x120\n" +
+	"\x04type\x18\x03 \x01(\v2\x1c.tfdocs.plugin.v2.TypeSchemaR\x04type\x12\x1a\n" +
+	"\boptional\x18\x04 \x01(\bR\boptional\x12\x18\n" +
+	"\adefault\x18\x05 \x01(\fR\adefault\"\xe6\x02\n" +
+	"\n" +
+	"ModuleCall\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x126\n" +
+	"\bposition\x18\x05 \x01(\v2\x1a.tfdocs.plugin.v2.PositionR\bposition\x12#\n" +
+	"\rmeta_argument\x18\x06 \x01(\tR\fmetaArgument\x12\x18\n" +
+	"\aaddress\x18\a \x01(\tR\aaddress\x128\n" +
+	"\tresources\x18\b \x03(\v2\x1a.tfdocs.plugin.v2.ResourceR\tresources\x12?\n" +
+	"\fmodule_calls\x18\t \x03(\v2\x1c.tfdocs.plugin.v2.ModuleCallR\vmoduleCalls\"\xc9\x01\n" +
+	"\x06Output\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\fR\x05value\x12\x1c\n" +
+	"\tsensitive\x18\x04 \x01(\bR\tsensitive\x12\x1d\n" +
+	"\n" +
+	"show_value\x18\x05 \x01(\bR\tshowValue\x126\n" +
+	"\bposition\x18\x06 \x01(\v2\x1a.tfdocs.plugin.v2.PositionR\bposition\"\xee\x01\n" +
+	"\bProvider\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05alias\x18\x02 \x01(\tR\x05alias\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x126\n" +
+	"\bposition\x18\x04 \x01(\v2\x1a.tfdocs.plugin.v2.PositionR\bposition\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x12\x1e\n" +
+	"\n" +
+	"constraint\x18\x06 \x01(\tR\n" +
+	"constraint\x12\x16\n" +
+	"\x06locked\x18\a \x01(\tR\x06locked\x12\x16\n" +
+	"\x06hashes\x18\b \x03(\tR\x06hashes\"\x88\x01\n" +
+	"\vRequirement\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x123\n" +
+	"\x15configuration_aliases\x18\x04 \x03(\tR\x14configurationAliases\"\xc7\x02\n" +
+	"\bResource\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rprovider_name\x18\x03 \x01(\tR\fproviderName\x12'\n" +
+	"\x0fprovider_source\x18\x04 \x01(\tR\x0eproviderSource\x12\x12\n" +
+	"\x04mode\x18\x05 \x01(\tR\x04mode\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\tR\aversion\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x126\n" +
+	"\bposition\x18\b \x01(\v2\x1a.tfdocs.plugin.v2.PositionR\bposition\x12#\n" +
+	"\rmeta_argument\x18\t \x01(\tR\fmetaArgument\x12\x18\n" +
+	"\aaddress\x18\n" +
+	" \x01(\tR\aaddress\"\x9c\x01\n" +
+	"\x05Check\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x1c\n" +
+	"\tcondition\x18\x03 \x01(\tR\tcondition\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\x12\x12\n" +
+	"\x04file\x18\x05 \x01(\tR\x04file\x12\x12\n" +
+	"\x04line\x18\x06 \x01(\x03R\x04line\"\x9d\x01\n" +
+	"\tMigration\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\tR\x02id\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04file\x18\x06 \x01(\tR\x04file\x12\x12\n" +
+	"\x04line\x18\a \x01(\x03R\x04line\"\xa8\x12\n" +
+	"\x06Config\x12\x1c\n" +
+	"\tformatter\x18\x01 \x01(\tR\tformatter\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1f\n" +
+	"\vheader_from\x18\x03 \x01(\tR\n" +
+	"headerFrom\x12\x1f\n" +
+	"\vfooter_from\x18\x04 \x01(\tR\n" +
+	"footerFrom\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12=\n" +
+	"\bsections\x18\x06 \x01(\v2!.tfdocs.plugin.v2.Config.SectionsR\bsections\x127\n" +
+	"\x06output\x18\a \x01(\v2\x1f.tfdocs.plugin.v2.Config.OutputR\x06output\x12J\n" +
+	"\routput_values\x18\b \x01(\v2%.tfdocs.plugin.v2.Config.OutputValuesR\foutputValues\x121\n" +
+	"\x04sort\x18\t \x01(\v2\x1d.tfdocs.plugin.v2.Config.SortR\x04sort\x12=\n" +
+	"\bsettings\x18\n" +
+	" \x01(\v2!.tfdocs.plugin.v2.Config.SettingsR\bsettings\x12\x1f\n" +
+	"\vmodule_root\x18\v \x01(\tR\n" +
+	"moduleRoot\x12M\n" +
+	"\x0eexample_values\x18\f \x01(\v2&.tfdocs.plugin.v2.Config.ExampleValuesR\rexampleValues\x124\n" +
+	"\x05usage\x18\r \x01(\v2\x1e.tfdocs.plugin.v2.Config.UsageR\x05usage\x12=\n" +
+	"\bregistry\x18\x0e \x01(\v2!.tfdocs.plugin.v2.Config.RegistryR\bregistry\x1a\xf2\x02\n" +
+	"\bSections\x12\x12\n" +
+	"\x04show\x18\x01 \x03(\tR\x04show\x12\x12\n" +
+	"\x04hide\x18\x02 \x03(\tR\x04hide\x12\x16\n" +
+	"\x06checks\x18\x03 \x01(\bR\x06checks\x12!\n" +
+	"\fdata_sources\x18\x04 \x01(\bR\vdataSources\x12\x16\n" +
+	"\x06header\x18\x05 \x01(\bR\x06header\x12\x16\n" +
+	"\x06footer\x18\x06 \x01(\bR\x06footer\x12\x16\n" +
+	"\x06inputs\x18\a \x01(\bR\x06inputs\x12\x1e\n" +
+	"\n" +
+	"migrations\x18\b \x01(\bR\n" +
+	"migrations\x12!\n" +
+	"\fmodule_calls\x18\t \x01(\bR\vmoduleCalls\x12\x18\n" +
+	"\aoutputs\x18\n" +
+	" \x01(\bR\aoutputs\x12\x1c\n" +
+	"\tproviders\x18\v \x01(\bR\tproviders\x12\"\n" +
+	"\frequirements\x18\f \x01(\bR\frequirements\x12\x1c\n" +
+	"\tresources\x18\r \x01(\bR\tresources\x1ab\n" +
+	"\x06Output\x12\x12\n" +
+	"\x04file\x18\x01 \x01(\tR\x04file\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12\x1a\n" +
+	"\btemplate\x18\x03 \x01(\tR\btemplate\x12\x14\n" +
+	"\x05check\x18\x04 \x01(\bR\x05check\x1a<\n" +
+	"\fOutputValues\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x1a=\n" +
+	"\rExampleValues\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x12\n" +
+	"\x04from\x18\x02 \x03(\tR\x04from\x1aM\n" +
+	"\x05Usage\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x1a\xd2\x02\n" +
+	"\bRegistry\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12<\n" +
+	"\x05hosts\x18\x02 \x03(\v2&.tfdocs.plugin.v2.Config.Registry.HostR\x05hosts\x12H\n" +
+	"\tproviders\x18\x03 \x03(\v2*.tfdocs.plugin.v2.Config.Registry.ProviderR\tproviders\x1aR\n" +
+	"\x04Host\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x1a\n" +
+	"\bresource\x18\x03 \x01(\tR\bresource\x1aV\n" +
+	"\bProvider\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x1a\n" +
+	"\bresource\x18\x03 \x01(\tR\bresource\x1a0\n" +
+	"\x04Sort\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x0e\n" +
+	"\x02by\x18\x02 \x01(\tR\x02by\x1a\xc2\x04\n" +
+	"\bSettings\x12\x16\n" +
+	"\x06anchor\x18\x01 \x01(\bR\x06anchor\x12\x1e\n" +
+	"\n" +
+	"attributes\x18\x02 \x01(\bR\n" +
+	"attributes\x12\x1d\n" +
+	"\n" +
+	"atx_closed\x18\x03 \x01(\bR\tatxClosed\x12\x14\n" +
+	"\x05color\x18\x04 \x01(\bR\x05color\x12\x18\n" +
+	"\adefault\x18\x05 \x01(\bR\adefault\x12 \n" +
+	"\vdescription\x18\x06 \x01(\bR\vdescription\x12\x1c\n" +
+	"\tephemeral\x18\a \x01(\bR\tephemeral\x12\x16\n" +
+	"\x06escape\x18\b \x01(\bR\x06escape\x12\x1d\n" +
+	"\n" +
+	"hide_empty\x18\t \x01(\bR\thideEmpty\x12\x12\n" +
+	"\x04html\x18\n" +
+	" \x01(\bR\x04html\x12\x16\n" +
+	"\x06indent\x18\v \x01(\x03R\x06indent\x12\x1a\n" +
+	"\blockfile\x18\f \x01(\bR\blockfile\x12\x1a\n" +
+	"\bnullable\x18\r \x01(\bR\bnullable\x12#\n" +
+	"\rread_comments\x18\x0e \x01(\bR\freadComments\x12\x1a\n" +
+	"\brequired\x18\x0f \x01(\bR\brequired\x12\x1c\n" +
+	"\tsensitive\x18\x10 \x01(\bR\tsensitive\x12\x12\n" +
+	"\x04type\x18\x11 \x01(\bR\x04type\x12\x1e\n" +
+	"\n" +
+	"validation\x18\x12 \x01(\bR\n" +
+	"validation\x12)\n" +
+	"\x10nested_resources\x18\x13 \x01(\bR\x0fnestedResources\x12\x16\n" +
+	"\x06source\x18\x14 \x01(\bR\x06source2\xdf\x02\n" +
+	"\x06Plugin\x12V\n" +
+	"\tHandshake\x12#.tfdocs.plugin.v2.Handshake.Request\x1a$.tfdocs.plugin.v2.Handshake.Response\x12P\n" +
+	"\aExecute\x12!.tfdocs.plugin.v2.Execute.Request\x1a\".tfdocs.plugin.v2.Execute.Response\x12M\n" +
+	"\x06Enrich\x12 .tfdocs.plugin.v2.Enrich.Request\x1a!.tfdocs.plugin.v2.Enrich.Response\x12\\\n" +
+	"\vPostProcess\x12%.tfdocs.plugin.v2.PostProcess.Request\x1a&.tfdocs.plugin.v2.PostProcess.ResponseB7Z5github.com/terraform-docs/terraform-docs/plugin/protob\x06proto3"
+
+var (
+	file_plugin_proto_plugin_proto_rawDescOnce sync.Once
+	file_plugin_proto_plugin_proto_rawDescData []byte
+)
+
+func file_plugin_proto_plugin_proto_rawDescGZIP() []byte {
+	file_plugin_proto_plugin_proto_rawDescOnce.Do(func() {
+		file_plugin_proto_plugin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugin_proto_plugin_proto_rawDesc), len(file_plugin_proto_plugin_proto_rawDesc)))
+	})
+	return file_plugin_proto_plugin_proto_rawDescData
+}
+
+var file_plugin_proto_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_plugin_proto_plugin_proto_goTypes = []any{
+	(*Handshake)(nil),                // 0: tfdocs.plugin.v2.Handshake
+	(*Execute)(nil),                  // 1: tfdocs.plugin.v2.Execute
+	(*Enrich)(nil),                   // 2: tfdocs.plugin.v2.Enrich
+	(*PostProcess)(nil),              // 3: tfdocs.plugin.v2.PostProcess
+	(*Module)(nil),                   // 4: tfdocs.plugin.v2.Module
+	(*Position)(nil),                 // 5: tfdocs.plugin.v2.Position
+	(*Input)(nil),                    // 6: tfdocs.plugin.v2.Input
+	(*Validation)(nil),               // 7: tfdocs.plugin.v2.Validation
+	(*TypeSchema)(nil),               // 8: tfdocs.plugin.v2.TypeSchema
+	(*TypeAttribute)(nil),            // 9: tfdocs.plugin.v2.TypeAttribute
+	(*ModuleCall)(nil),               // 10: tfdocs.plugin.v2.ModuleCall
+	(*Output)(nil),                   // 11: tfdocs.plugin.v2.Output
+	(*Provider)(nil),                 // 12: tfdocs.plugin.v2.Provider
+	(*Requirement)(nil),              // 13: tfdocs.plugin.v2.Requirement
+	(*Resource)(nil),                 // 14: tfdocs.plugin.v2.Resource
+	(*Check)(nil),                    // 15: tfdocs.plugin.v2.Check
+	(*Migration)(nil),                // 16: tfdocs.plugin.v2.Migration
+	(*Config)(nil),                   // 17: tfdocs.plugin.v2.Config
+	(*Handshake_Request)(nil),        // 18: tfdocs.plugin.v2.Handshake.Request
+	(*Handshake_Response)(nil),       // 19: tfdocs.plugin.v2.Handshake.Response
+	(*Execute_Request)(nil),          // 20: tfdocs.plugin.v2.Execute.Request
+	(*Execute_Response)(nil),         // 21: tfdocs.plugin.v2.Execute.Response
+	(*Enrich_Request)(nil),           // 22: tfdocs.plugin.v2.Enrich.Request
+	(*Enrich_Response)(nil),          // 23: tfdocs.plugin.v2.Enrich.Response
+	(*PostProcess_Request)(nil),      // 24: tfdocs.plugin.v2.PostProcess.Request
+	(*PostProcess_Response)(nil),     // 25: tfdocs.plugin.v2.PostProcess.Response
+	(*Config_Sections)(nil),          // 26: tfdocs.plugin.v2.Config.Sections
+	(*Config_Output)(nil),            // 27: tfdocs.plugin.v2.Config.Output
+	(*Config_OutputValues)(nil),      // 28: tfdocs.plugin.v2.Config.OutputValues
+	(*Config_ExampleValues)(nil),     // 29: tfdocs.plugin.v2.Config.ExampleValues
+	(*Config_Usage)(nil),             // 30: tfdocs.plugin.v2.Config.Usage
+	(*Config_Registry)(nil),          // 31: tfdocs.plugin.v2.Config.Registry
+	(*Config_Sort)(nil),              // 32: tfdocs.plugin.v2.Config.Sort
+	(*Config_Settings)(nil),          // 33: tfdocs.plugin.v2.Config.Settings
+	(*Config_Registry_Host)(nil),     // 34: tfdocs.plugin.v2.Config.Registry.Host
+	(*Config_Registry_Provider)(nil), // 35: tfdocs.plugin.v2.Config.Registry.Provider
+}
+var file_plugin_proto_plugin_proto_depIdxs = []int32{
+	6,  // 0: tfdocs.plugin.v2.Module.inputs:type_name -> tfdocs.plugin.v2.Input
+	10, // 1: tfdocs.plugin.v2.Module.module_calls:type_name -> tfdocs.plugin.v2.ModuleCall
+	11, // 2: tfdocs.plugin.v2.Module.outputs:type_name -> tfdocs.plugin.v2.Output
+	12, // 3: tfdocs.plugin.v2.Module.providers:type_name -> tfdocs.plugin.v2.Provider
+	13, // 4: tfdocs.plugin.v2.Module.requirements:type_name -> tfdocs.plugin.v2.Requirement
+	14, // 5: tfdocs.plugin.v2.Module.resources:type_name -> tfdocs.plugin.v2.Resource
+	15, // 6: tfdocs.plugin.v2.Module.checks:type_name -> tfdocs.plugin.v2.Check
+	16, // 7: tfdocs.plugin.v2.Module.migrations:type_name -> tfdocs.plugin.v2.Migration
+	8,  // 8: tfdocs.plugin.v2.Input.type_schema:type_name -> tfdocs.plugin.v2.TypeSchema
+	7,  // 9: tfdocs.plugin.v2.Input.validations:type_name -> tfdocs.plugin.v2.Validation
+	5,  // 10: tfdocs.plugin.v2.Input.position:type_name -> tfdocs.plugin.v2.Position
+	8,  // 11: tfdocs.plugin.v2.TypeSchema.element:type_name -> tfdocs.plugin.v2.TypeSchema
+	8,  // 12: tfdocs.plugin.v2.TypeSchema.elements:type_name -> tfdocs.plugin.v2.TypeSchema
+	9,  // 13: tfdocs.plugin.v2.TypeSchema.attributes:type_name -> tfdocs.plugin.v2.TypeAttribute
+	8,  // 14: tfdocs.plugin.v2.TypeAttribute.type:type_name -> tfdocs.plugin.v2.TypeSchema
+	5,  // 15: tfdocs.plugin.v2.ModuleCall.position:type_name -> tfdocs.plugin.v2.Position
+	14, // 16: tfdocs.plugin.v2.ModuleCall.resources:type_name -> tfdocs.plugin.v2.Resource
+	10, // 17: tfdocs.plugin.v2.ModuleCall.module_calls:type_name -> tfdocs.plugin.v2.ModuleCall
+	5,  // 18: tfdocs.plugin.v2.Output.position:type_name -> tfdocs.plugin.v2.Position
+	5,  // 19: tfdocs.plugin.v2.Provider.position:type_name -> tfdocs.plugin.v2.Position
+	5,  // 20: tfdocs.plugin.v2.Resource.position:type_name -> tfdocs.plugin.v2.Position
+	26, // 21: tfdocs.plugin.v2.Config.sections:type_name -> tfdocs.plugin.v2.Config.Sections
+	27, // 22: tfdocs.plugin.v2.Config.output:type_name -> tfdocs.plugin.v2.Config.Output
+	28, // 23: tfdocs.plugin.v2.Config.output_values:type_name -> tfdocs.plugin.v2.Config.OutputValues
+	32, // 24: tfdocs.plugin.v2.Config.sort:type_name -> tfdocs.plugin.v2.Config.Sort
+	33, // 25: tfdocs.plugin.v2.Config.settings:type_name -> tfdocs.plugin.v2.Config.Settings
+	29, // 26: tfdocs.plugin.v2.Config.example_values:type_name -> tfdocs.plugin.v2.Config.ExampleValues
+	30, // 27: tfdocs.plugin.v2.Config.usage:type_name -> tfdocs.plugin.v2.Config.Usage
+	31, // 28: tfdocs.plugin.v2.Config.registry:type_name -> tfdocs.plugin.v2.Config.Registry
+	4,  // 29: tfdocs.plugin.v2.Execute.Request.module:type_name -> tfdocs.plugin.v2.Module
+	17, // 30: tfdocs.plugin.v2.Execute.Request.config:type_name -> tfdocs.plugin.v2.Config
+	4,  // 31: tfdocs.plugin.v2.Enrich.Request.module:type_name -> tfdocs.plugin.v2.Module
+	17, // 32: tfdocs.plugin.v2.Enrich.Request.config:type_name -> tfdocs.plugin.v2.Config
+	4,  // 33: tfdocs.plugin.v2.Enrich.Response.module:type_name -> tfdocs.plugin.v2.Module
+	17, // 34: tfdocs.plugin.v2.PostProcess.Request.config:type_name -> tfdocs.plugin.v2.Config
+	34, // 35: tfdocs.plugin.v2.Config.Registry.hosts:type_name -> tfdocs.plugin.v2.Config.Registry.Host
+	35, // 36: tfdocs.plugin.v2.Config.Registry.providers:type_name -> tfdocs.plugin.v2.Config.Registry.Provider
+	18, // 37: tfdocs.plugin.v2.Plugin.Handshake:input_type -> tfdocs.plugin.v2.Handshake.Request
+	20, // 38: tfdocs.plugin.v2.Plugin.Execute:input_type -> tfdocs.plugin.v2.Execute.Request
+	22, // 39: tfdocs.plugin.v2.Plugin.Enrich:input_type -> tfdocs.plugin.v2.Enrich.Request
+	24, // 40: tfdocs.plugin.v2.Plugin.PostProcess:input_type -> tfdocs.plugin.v2.PostProcess.Request
+	19, // 41: tfdocs.plugin.v2.Plugin.Handshake:output_type -> tfdocs.plugin.v2.Handshake.Response
+	21, // 42: tfdocs.plugin.v2.Plugin.Execute:output_type -> tfdocs.plugin.v2.Execute.Response
+	23, // 43: tfdocs.plugin.v2.Plugin.Enrich:output_type -> tfdocs.plugin.v2.Enrich.Response
+	25, // 44: tfdocs.plugin.v2.Plugin.PostProcess:output_type -> tfdocs.plugin.v2.PostProcess.Response
+	41, // [41:45] is the sub-list for method output_type
+	37, // [37:41] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
+}
+
+func init() { file_plugin_proto_plugin_proto_init() }
+func file_plugin_proto_plugin_proto_init() {
+	if File_plugin_proto_plugin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugin_proto_plugin_proto_rawDesc), len(file_plugin_proto_plugin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   36,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_plugin_proto_plugin_proto_goTypes,
+		DependencyIndexes: file_plugin_proto_plugin_proto_depIdxs,
+		MessageInfos:      file_plugin_proto_plugin_proto_msgTypes,
+	}.Build()
+	File_plugin_proto_plugin_proto = out.File
+	file_plugin_proto_plugin_proto_goTypes = nil
+	file_plugin_proto_plugin_proto_depIdxs = nil
+}
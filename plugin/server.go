@@ -25,20 +25,32 @@ type Server struct {
 
 type printFunc func(*print.Config, *terraform.Module) (string, error)
 
-// ServeOpts is an option for serving a plugin.
+type enrichFunc func(*print.Config, *terraform.Module) (*terraform.Module, error)
+
+type postProcessFunc func(*print.Config, string) (string, error)
+
+// ServeOpts is an option for serving a plugin. A plugin can have any of the
+// Printer, Enricher and PostProcessor set, which are reported to the host as
+// its capabilities.
 type ServeOpts struct {
 	Name    string
 	Version string
 	Printer printFunc
+
+	// Enricher receives the loaded module and returns the mutated one.
+	Enricher enrichFunc
+
+	// PostProcessor receives the generated content and returns the
+	// processed one.
+	PostProcessor postProcessFunc
 }
 
 // Serve is a wrapper of plugin.Serve. This is entrypoint of all plugins.
 func Serve(opts *ServeOpts) {
 	goplugin.Serve(&goplugin.ServeConfig{
-		HandshakeConfig: handshakeConfig,
-		Plugins: goplugin.PluginSet{
-			"formatter": newFormatter(opts.Name, opts.Version, opts.Printer),
-		},
+		HandshakeConfig:  handshakeConfig,
+		VersionedPlugins: pluginSets(newFormatter(opts)),
+		GRPCServer:       goplugin.DefaultGRPCServer,
 	})
 }
 
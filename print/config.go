@@ -11,30 +11,41 @@ the root directory of this source tree.
 package print
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"strings"
+	gotemplate "text/template"
 
+	"github.com/bmatcuk/doublestar/v4"
 	"github.com/spf13/viper"
 )
 
 // Config represents all the available config options that can be accessed and
 // passed through CLI.
 type Config struct {
-	File         string       `mapstructure:"-"`
-	Formatter    string       `mapstructure:"formatter"`
-	Version      string       `mapstructure:"version"`
-	HeaderFrom   string       `mapstructure:"header-from"`
-	FooterFrom   string       `mapstructure:"footer-from"`
-	Recursive    recursive    `mapstructure:"recursive"`
-	Content      string       `mapstructure:"content"`
-	Sections     sections     `mapstructure:"sections"`
-	Output       output       `mapstructure:"output"`
-	OutputValues outputvalues `mapstructure:"output-values"`
-	Sort         sort         `mapstructure:"sort"`
-	Settings     settings     `mapstructure:"settings"`
+	File          string        `mapstructure:"-"`
+	Formatter     string        `mapstructure:"formatter"`
+	Version       string        `mapstructure:"version"`
+	HeaderFrom    string        `mapstructure:"header-from"`
+	FooterFrom    string        `mapstructure:"footer-from"`
+	Recursive     recursive     `mapstructure:"recursive"`
+	Content       string        `mapstructure:"content"`
+	Sections      sections      `mapstructure:"sections"`
+	Output        output        `mapstructure:"output"`
+	OutputValues  outputvalues  `mapstructure:"output-values"`
+	ExampleValues examplevalues `mapstructure:"example-values"`
+	Usage         usage         `mapstructure:"usage"`
+	HTML          html          `mapstructure:"html"`
+	Registry      registry      `mapstructure:"registry"`
+	Sort          sort          `mapstructure:"sort"`
+	Settings      settings      `mapstructure:"settings"`
+	Plugins       plugins       `mapstructure:"plugins"`
 
 	ModuleRoot string
 }
@@ -42,31 +53,41 @@ type Config struct {
 // NewConfig returns neew instancee of Config with empty values.
 func NewConfig() *Config {
 	return &Config{
-		HeaderFrom:   "main.tf",
-		Recursive:    recursive{},
-		Sections:     sections{},
-		Output:       output{},
-		OutputValues: outputvalues{},
-		Sort:         sort{},
-		Settings:     settings{},
+		HeaderFrom:    "main.tf",
+		Recursive:     recursive{},
+		Sections:      sections{},
+		Output:        output{},
+		OutputValues:  outputvalues{},
+		ExampleValues: examplevalues{},
+		Usage:         usage{},
+		HTML:          html{},
+		Registry:      registry{},
+		Sort:          sort{},
+		Settings:      settings{},
+		Plugins:       plugins{},
 	}
 }
 
 // DefaultConfig returns new instance of Config with default values set.
 func DefaultConfig() *Config {
 	return &Config{
-		File:         "",
-		Formatter:    "",
-		Version:      "",
-		HeaderFrom:   "main.tf",
-		FooterFrom:   "",
-		Recursive:    defaultRecursive(),
-		Content:      "",
-		Sections:     defaultSections(),
-		Output:       defaultOutput(),
-		OutputValues: defaultOutputValues(),
-		Sort:         defaultSort(),
-		Settings:     defaultSettings(),
+		File:          "",
+		Formatter:     "",
+		Version:       "",
+		HeaderFrom:    "main.tf",
+		FooterFrom:    "",
+		Recursive:     defaultRecursive(),
+		Content:       "",
+		Sections:      defaultSections(),
+		Output:        defaultOutput(),
+		OutputValues:  defaultOutputValues(),
+		ExampleValues: defaultExampleValues(),
+		Usage:         defaultUsage(),
+		HTML:          defaultHTML(),
+		Registry:      defaultRegistry(),
+		Sort:          defaultSort(),
+		Settings:      defaultSettings(),
+		Plugins:       defaultPlugins(),
 
 		ModuleRoot: "",
 	}
@@ -76,7 +97,19 @@ type recursive struct {
 	Enabled     bool     `mapstructure:"enabled"`
 	Path        string   `mapstructure:"path"`
 	IncludeMain bool     `mapstructure:"include-main"`
+	Include     []string `mapstructure:"include"`
 	Exclude     []string `mapstructure:"exclude"`
+	Gitignore   bool     `mapstructure:"gitignore"`
+	MaxDepth    int      `mapstructure:"max-depth"`
+	Parallelism int      `mapstructure:"parallelism"`
+
+	Index recursiveIndex `mapstructure:"index"`
+}
+
+type recursiveIndex struct {
+	Enabled  bool   `mapstructure:"enabled"`
+	File     string `mapstructure:"file"`
+	Template string `mapstructure:"template"`
 }
 
 func defaultRecursive() recursive {
@@ -84,23 +117,49 @@ func defaultRecursive() recursive {
 		Enabled:     false,
 		Path:        "modules",
 		IncludeMain: true,
+		Include:     []string{},
 		Exclude:     []string{},
+		Gitignore:   true,
+		MaxDepth:    0,
+		Parallelism: 1,
+
+		Index: recursiveIndex{
+			Enabled:  false,
+			File:     "",
+			Template: "",
+		},
 	}
 }
 
 func (r *recursive) validate() error {
-	if r.Enabled && r.Path == "" {
+	if !r.Enabled {
+		return nil
+	}
+	if r.Path == "" && len(r.Include) == 0 {
 		return fmt.Errorf("value of '--recursive-path' can't be empty")
 	}
+	for _, pattern := range slices.Concat(r.Include, r.Exclude) {
+		if !doublestar.ValidatePattern(pattern) {
+			return fmt.Errorf("'%s' is not a valid glob pattern", pattern)
+		}
+	}
+	if r.MaxDepth < 0 {
+		return fmt.Errorf("value of '--recursive-max-depth' can't be negative")
+	}
+	if r.Parallelism < 1 {
+		return fmt.Errorf("value of '--recursive-parallelism' must be greater than zero")
+	}
 	return nil
 }
 
 const (
 	sectionAll          = "all"
+	sectionChecks       = "checks"
 	sectionDataSources  = "data-sources"
 	sectionFooter       = "footer"
 	sectionHeader       = "header"
 	sectionInputs       = "inputs"
+	sectionMigrations   = "migrations"
 	sectionModules      = "modules"
 	sectionOutputs      = "outputs"
 	sectionProviders    = "providers"
@@ -110,10 +169,12 @@ const (
 
 var allSections = []string{
 	sectionAll,
+	sectionChecks,
 	sectionDataSources,
 	sectionFooter,
 	sectionHeader,
 	sectionInputs,
+	sectionMigrations,
 	sectionModules,
 	sectionOutputs,
 	sectionProviders,
@@ -128,10 +189,12 @@ type sections struct {
 	Show []string `mapstructure:"show"`
 	Hide []string `mapstructure:"hide"`
 
+	Checks       bool
 	DataSources  bool
 	Header       bool
 	Footer       bool
 	Inputs       bool
+	Migrations   bool
 	ModuleCalls  bool
 	Outputs      bool
 	Providers    bool
@@ -144,10 +207,12 @@ func defaultSections() sections {
 		Show: []string{},
 		Hide: []string{},
 
+		Checks:       true,
 		DataSources:  true,
 		Header:       true,
 		Footer:       false,
 		Inputs:       true,
+		Migrations:   true,
 		ModuleCalls:  true,
 		Outputs:      true,
 		Providers:    true,
@@ -217,6 +282,8 @@ type output struct {
 	Template string `mapstructure:"template"`
 	Check    bool
 
+	CheckReport string
+
 	BeginComment string
 	EndComment   string
 }
@@ -228,6 +295,8 @@ func defaultOutput() output {
 		Template: OutputTemplate,
 		Check:    false,
 
+		CheckReport: "",
+
 		BeginComment: OutputBeginComment,
 		EndComment:   OutputEndComment,
 	}
@@ -308,6 +377,12 @@ func isInlineComment(line string) bool {
 	case strings.HasPrefix(line, "//"):
 		return true
 
+	// reStructuredText specific, excluding directives, labels, footnotes and
+	// substitutions which share the same prefix
+	case strings.HasPrefix(line, ".. "):
+		text := strings.TrimPrefix(line, ".. ")
+		return text != "" && !strings.Contains(text, "::") && !strings.ContainsAny(text[:1], "_[|")
+
 	// Markdown specific
 	default:
 		cases := [][]string{
@@ -347,6 +422,170 @@ func (o *outputvalues) validate() error {
 	return nil
 }
 
+type examplevalues struct {
+	Enabled bool     `mapstructure:"enabled"`
+	From    []string `mapstructure:"from"`
+}
+
+func defaultExampleValues() examplevalues {
+	return examplevalues{
+		Enabled: false,
+		From:    []string{},
+	}
+}
+
+func (e *examplevalues) validate() error {
+	for _, from := range e.From {
+		if from == "" {
+			return fmt.Errorf("value of '--example-values-from' can't be empty")
+		}
+	}
+	return nil
+}
+
+var moduleNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+type usage struct {
+	Name    string `mapstructure:"name"`
+	Source  string `mapstructure:"source"`
+	Version string `mapstructure:"version"`
+}
+
+func defaultUsage() usage {
+	return usage{
+		Name:    "",
+		Source:  "",
+		Version: "",
+	}
+}
+
+func (u *usage) validate() error {
+	if u.Name != "" && !moduleNameRegex.MatchString(u.Name) {
+		return fmt.Errorf("value of '--usage-name' is not a valid module name")
+	}
+	return nil
+}
+
+// HTML themes.
+const (
+	HTMLThemeAuto  = "auto"
+	HTMLThemeDark  = "dark"
+	HTMLThemeLight = "light"
+)
+
+var allHTMLThemes = []string{
+	HTMLThemeAuto,
+	HTMLThemeDark,
+	HTMLThemeLight,
+}
+
+// HTMLThemes list.
+var HTMLThemes = strings.Join(allHTMLThemes, ", ")
+
+type html struct {
+	Theme      string `mapstructure:"theme"`
+	Stylesheet string `mapstructure:"stylesheet"`
+}
+
+func defaultHTML() html {
+	return html{
+		Theme:      HTMLThemeAuto,
+		Stylesheet: "",
+	}
+}
+
+func (h *html) validate() error {
+	if h.Theme != "" && !contains(allHTMLThemes, h.Theme) {
+		return fmt.Errorf("'%s' is not a valid html theme", h.Theme)
+	}
+	return nil
+}
+
+// DefaultRegistryHost is the host of providers whose source doesn't have any
+// host, e.g. 'hashicorp/aws'.
+const DefaultRegistryHost = "registry.terraform.io"
+
+type registry struct {
+	Host      string             `mapstructure:"host"`
+	Hosts     []registryHost     `mapstructure:"hosts"`
+	Providers []registryProvider `mapstructure:"providers"`
+}
+
+// registryHost holds the templates of documentation URLs of the providers
+// (and their resources) of a registry host, e.g. a private registry.
+type registryHost struct {
+	Host     string `mapstructure:"host"`
+	Provider string `mapstructure:"provider"`
+	Resource string `mapstructure:"resource"`
+}
+
+// registryProvider holds the templates of documentation URLs of a provider
+// (and its resources), which take precedence over the ones of its host.
+type registryProvider struct {
+	Name     string `mapstructure:"name"`
+	Provider string `mapstructure:"provider"`
+	Resource string `mapstructure:"resource"`
+}
+
+// AddHost adds the templates of documentation URLs of the providers (and their
+// resources) of registry 'host'.
+func (r *registry) AddHost(host string, provider string, resource string) {
+	r.Hosts = append(r.Hosts, registryHost{Host: host, Provider: provider, Resource: resource})
+}
+
+// AddProvider adds the templates of documentation URLs of provider with local
+// 'name' (and its resources).
+func (r *registry) AddProvider(name string, provider string, resource string) {
+	r.Providers = append(r.Providers, registryProvider{Name: name, Provider: provider, Resource: resource})
+}
+
+func defaultRegistry() registry {
+	return registry{
+		Host:      DefaultRegistryHost,
+		Hosts:     []registryHost{},
+		Providers: []registryProvider{},
+	}
+}
+
+func (r *registry) validate() error {
+	hosts := map[string]bool{}
+	for _, h := range r.Hosts {
+		if h.Host == "" {
+			return fmt.Errorf("value of 'registry.hosts.host' can't be empty")
+		}
+		if hosts[h.Host] {
+			return fmt.Errorf("host '%s' is declared more than once in 'registry.hosts'", h.Host)
+		}
+		hosts[h.Host] = true
+		if err := validateURLTemplates(h.Host, h.Provider, h.Resource); err != nil {
+			return err
+		}
+	}
+	names := map[string]bool{}
+	for _, p := range r.Providers {
+		if p.Name == "" {
+			return fmt.Errorf("value of 'registry.providers.name' can't be empty")
+		}
+		if names[p.Name] {
+			return fmt.Errorf("provider '%s' is declared more than once in 'registry.providers'", p.Name)
+		}
+		names[p.Name] = true
+		if err := validateURLTemplates(p.Name, p.Provider, p.Resource); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateURLTemplates(name string, templates ...string) error {
+	for _, t := range templates {
+		if _, err := gotemplate.New(name).Parse(t); err != nil {
+			return fmt.Errorf("invalid URL template of '%s' in 'registry': %w", name, err)
+		}
+	}
+	return nil
+}
+
 // Sort types.
 const (
 	SortName     = "name"
@@ -383,38 +622,50 @@ func (s *sort) validate() error {
 }
 
 type settings struct {
-	Anchor       bool `mapstructure:"anchor"`
-	AtxClosed    bool `mapstructure:"atx-closed"`
-	Color        bool `mapstructure:"color"`
-	Default      bool `mapstructure:"default"`
-	Description  bool `mapstructure:"description"`
-	Escape       bool `mapstructure:"escape"`
-	HideEmpty    bool `mapstructure:"hide-empty"`
-	HTML         bool `mapstructure:"html"`
-	Indent       int  `mapstructure:"indent"`
-	LockFile     bool `mapstructure:"lockfile"`
-	ReadComments bool `mapstructure:"read-comments"`
-	Required     bool `mapstructure:"required"`
-	Sensitive    bool `mapstructure:"sensitive"`
-	Type         bool `mapstructure:"type"`
+	Anchor          bool `mapstructure:"anchor"`
+	Attributes      bool `mapstructure:"attributes"`
+	AtxClosed       bool `mapstructure:"atx-closed"`
+	Color           bool `mapstructure:"color"`
+	Default         bool `mapstructure:"default"`
+	Description     bool `mapstructure:"description"`
+	Ephemeral       bool `mapstructure:"ephemeral"`
+	Escape          bool `mapstructure:"escape"`
+	HideEmpty       bool `mapstructure:"hide-empty"`
+	HTML            bool `mapstructure:"html"`
+	Indent          int  `mapstructure:"indent"`
+	LockFile        bool `mapstructure:"lockfile"`
+	NestedResources bool `mapstructure:"nested-resources"`
+	Nullable        bool `mapstructure:"nullable"`
+	ReadComments    bool `mapstructure:"read-comments"`
+	Required        bool `mapstructure:"required"`
+	Sensitive       bool `mapstructure:"sensitive"`
+	Source          bool `mapstructure:"source"`
+	Type            bool `mapstructure:"type"`
+	Validation      bool `mapstructure:"validation"`
 }
 
 func defaultSettings() settings {
 	return settings{
-		Anchor:       true,
-		AtxClosed:    false,
-		Color:        true,
-		Default:      true,
-		Description:  false,
-		Escape:       true,
-		HideEmpty:    false,
-		HTML:         true,
-		Indent:       2,
-		LockFile:     true,
-		ReadComments: true,
-		Required:     true,
-		Sensitive:    true,
-		Type:         true,
+		Anchor:          true,
+		Attributes:      true,
+		AtxClosed:       false,
+		Color:           true,
+		Default:         true,
+		Description:     false,
+		Ephemeral:       true,
+		Escape:          true,
+		HideEmpty:       false,
+		HTML:            true,
+		Indent:          2,
+		LockFile:        true,
+		NestedResources: false,
+		Nullable:        true,
+		ReadComments:    true,
+		Required:        true,
+		Sensitive:       true,
+		Source:          false,
+		Type:            true,
+		Validation:      true,
 	}
 }
 
@@ -422,12 +673,72 @@ func (s *settings) validate() error {
 	return nil
 }
 
+type plugins struct {
+	Enrichers      []string       `mapstructure:"enrichers"`
+	PostProcessors []string       `mapstructure:"post-processors"`
+	Sources        []pluginSource `mapstructure:"sources"`
+}
+
+// pluginSource is an explicitly declared plugin, which takes precedence over
+// the ones found in plugins directories.
+type pluginSource struct {
+	Name     string `mapstructure:"name"`
+	Path     string `mapstructure:"path"`
+	Checksum string `mapstructure:"checksum"`
+}
+
+func defaultPlugins() plugins {
+	return plugins{
+		Enrichers:      []string{},
+		PostProcessors: []string{},
+		Sources:        []pluginSource{},
+	}
+}
+
+func (p *plugins) validate() error {
+	for _, name := range p.Enrichers {
+		if name == "" {
+			return fmt.Errorf("name of 'plugins.enrichers' can't be empty")
+		}
+	}
+	for _, name := range p.PostProcessors {
+		if name == "" {
+			return fmt.Errorf("name of 'plugins.post-processors' can't be empty")
+		}
+	}
+	names := map[string]bool{}
+	for _, source := range p.Sources {
+		if source.Name == "" {
+			return fmt.Errorf("value of 'plugins.sources.name' can't be empty")
+		}
+		if source.Path == "" {
+			return fmt.Errorf("value of 'plugins.sources.path' of plugin '%s' can't be empty", source.Name)
+		}
+		if source.Checksum != "" && !isSHA256(source.Checksum) {
+			return fmt.Errorf("value of 'plugins.sources.checksum' of plugin '%s' must be a SHA256 checksum", source.Name)
+		}
+		if names[source.Name] {
+			return fmt.Errorf("plugin '%s' is declared more than once in 'plugins.sources'", source.Name)
+		}
+		names[source.Name] = true
+	}
+	return nil
+}
+
+// isSHA256 returns true if 's' is a hex encoded SHA256 checksum.
+func isSHA256(s string) bool {
+	b, err := hex.DecodeString(s)
+	return err == nil && len(b) == sha256.Size
+}
+
 // Parse process config and set sections visibility.
 func (c *Config) Parse() {
 	// sections
+	c.Sections.Checks = c.Sections.visibility("checks")
 	c.Sections.DataSources = c.Sections.visibility("data-sources")
 	c.Sections.Header = c.Sections.visibility("header")
 	c.Sections.Inputs = c.Sections.visibility("inputs")
+	c.Sections.Migrations = c.Sections.visibility("migrations")
 	c.Sections.ModuleCalls = c.Sections.visibility("modules")
 	c.Sections.Outputs = c.Sections.visibility("outputs")
 	c.Sections.Providers = c.Sections.visibility("providers")
@@ -467,8 +778,13 @@ func (c *Config) Validate() error {
 		c.Sections.validate,
 		c.Output.validate,
 		c.OutputValues.validate,
+		c.ExampleValues.validate,
+		c.Usage.validate,
+		c.HTML.validate,
+		c.Registry.validate,
 		c.Sort.validate,
 		c.Settings.validate,
+		c.Plugins.validate,
 	} {
 		if err := fn(); err != nil {
 			return err
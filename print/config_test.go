@@ -14,6 +14,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -434,6 +435,27 @@ func TestIsInlineComment(t *testing.T) {
 			line:     "this // is not a comment",
 			expected: false,
 		},
+
+		{
+			name:     "rst comment variant",
+			line:     ".. this is a comment",
+			expected: true,
+		},
+		{
+			name:     "rst comment variant",
+			line:     ".. code-block:: hcl",
+			expected: false,
+		},
+		{
+			name:     "rst comment variant",
+			line:     ".. _this_is_a_label:",
+			expected: false,
+		},
+		{
+			name:     "rst comment variant",
+			line:     "this .. is not a comment",
+			expected: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -569,6 +591,30 @@ func TestConfigValidate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "value of '--recursive-path' can't be empty",
 		},
+		"RecursiveParallelismZero": {
+			config: func(c *Config) {
+				c.Recursive.Enabled = true
+				c.Recursive.Parallelism = 0
+			},
+			wantErr: true,
+			errMsg:  "value of '--recursive-parallelism' must be greater than zero",
+		},
+		"RecursiveMaxDepthNegative": {
+			config: func(c *Config) {
+				c.Recursive.Enabled = true
+				c.Recursive.MaxDepth = -1
+			},
+			wantErr: true,
+			errMsg:  "value of '--recursive-max-depth' can't be negative",
+		},
+		"RecursiveInvalidPattern": {
+			config: func(c *Config) {
+				c.Recursive.Enabled = true
+				c.Recursive.Include = []string{"modules/[a"}
+			},
+			wantErr: true,
+			errMsg:  "'modules/[a' is not a valid glob pattern",
+		},
 		"HeaderFromEmpty": {
 			config: func(c *Config) {
 				c.HeaderFrom = ""
@@ -593,6 +639,130 @@ func TestConfigValidate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "value of '--footer-from' can't equal value of '--header-from",
 		},
+		"ExampleValuesFromEmpty": {
+			config: func(c *Config) {
+				c.ExampleValues.Enabled = true
+				c.ExampleValues.From = []string{"examples", ""}
+			},
+			wantErr: true,
+			errMsg:  "value of '--example-values-from' can't be empty",
+		},
+		"UsageNameInvalid": {
+			config: func(c *Config) {
+				c.Usage.Name = "my module"
+			},
+			wantErr: true,
+			errMsg:  "value of '--usage-name' is not a valid module name",
+		},
+		"HTMLThemeInvalid": {
+			config: func(c *Config) {
+				c.HTML.Theme = "blue"
+			},
+			wantErr: true,
+			errMsg:  "'blue' is not a valid html theme",
+		},
+		"Registry": {
+			config: func(c *Config) {
+				c.Registry.Hosts = []registryHost{
+					{Host: "registry.acme.com", Provider: "https://docs.acme.com/{{ .Name }}", Resource: ""},
+				}
+				c.Registry.Providers = []registryProvider{
+					{Name: "aws", Provider: "", Resource: "https://docs.acme.com/aws/{{ .Type }}"},
+				}
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"RegistryHostEmpty": {
+			config: func(c *Config) {
+				c.Registry.Hosts = []registryHost{{Provider: "https://docs.acme.com/{{ .Name }}"}}
+			},
+			wantErr: true,
+			errMsg:  "value of 'registry.hosts.host' can't be empty",
+		},
+		"RegistryHostDuplicate": {
+			config: func(c *Config) {
+				c.Registry.Hosts = []registryHost{{Host: "registry.acme.com"}, {Host: "registry.acme.com"}}
+			},
+			wantErr: true,
+			errMsg:  "host 'registry.acme.com' is declared more than once in 'registry.hosts'",
+		},
+		"RegistryProviderNameEmpty": {
+			config: func(c *Config) {
+				c.Registry.Providers = []registryProvider{{Provider: "https://docs.acme.com/{{ .Name }}"}}
+			},
+			wantErr: true,
+			errMsg:  "value of 'registry.providers.name' can't be empty",
+		},
+		"RegistryProviderDuplicate": {
+			config: func(c *Config) {
+				c.Registry.Providers = []registryProvider{{Name: "aws"}, {Name: "aws"}}
+			},
+			wantErr: true,
+			errMsg:  "provider 'aws' is declared more than once in 'registry.providers'",
+		},
+		"RegistryInvalidTemplate": {
+			config: func(c *Config) {
+				c.Registry.Providers = []registryProvider{{Name: "aws", Resource: "https://docs.acme.com/{{ .Type"}}
+			},
+			wantErr: true,
+			errMsg:  "invalid URL template of 'aws' in 'registry': template: aws:1: unclosed action",
+		},
+		"PluginsEnricherEmpty": {
+			config: func(c *Config) {
+				c.Plugins.Enrichers = []string{"cost", ""}
+			},
+			wantErr: true,
+			errMsg:  "name of 'plugins.enrichers' can't be empty",
+		},
+		"PluginsPostProcessorEmpty": {
+			config: func(c *Config) {
+				c.Plugins.PostProcessors = []string{""}
+			},
+			wantErr: true,
+			errMsg:  "name of 'plugins.post-processors' can't be empty",
+		},
+		"PluginsSource": {
+			config: func(c *Config) {
+				c.Plugins.Sources = []pluginSource{
+					{Name: "foo", Path: "bin/tfdocs-format-foo", Checksum: strings.Repeat("ab", 32)},
+					{Name: "bar", Path: "bin/tfdocs-format-bar"},
+				}
+			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		"PluginsSourceNameEmpty": {
+			config: func(c *Config) {
+				c.Plugins.Sources = []pluginSource{{Path: "bin/tfdocs-format-foo"}}
+			},
+			wantErr: true,
+			errMsg:  "value of 'plugins.sources.name' can't be empty",
+		},
+		"PluginsSourcePathEmpty": {
+			config: func(c *Config) {
+				c.Plugins.Sources = []pluginSource{{Name: "foo"}}
+			},
+			wantErr: true,
+			errMsg:  "value of 'plugins.sources.path' of plugin 'foo' can't be empty",
+		},
+		"PluginsSourceInvalidChecksum": {
+			config: func(c *Config) {
+				c.Plugins.Sources = []pluginSource{{Name: "foo", Path: "bin/tfdocs-format-foo", Checksum: "abc"}}
+			},
+			wantErr: true,
+			errMsg:  "value of 'plugins.sources.checksum' of plugin 'foo' must be a SHA256 checksum",
+		},
+		"PluginsSourceDuplicate": {
+			config: func(c *Config) {
+				c.Plugins.Sources = []pluginSource{
+					{Name: "foo", Path: "bin/tfdocs-format-foo"},
+					{Name: "foo", Path: "bin/tfdocs-format-bar"},
+				}
+			},
+			wantErr: true,
+			errMsg:  "plugin 'foo' is declared more than once in 'plugins.sources'",
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
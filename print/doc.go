@@ -39,7 +39,9 @@ the root directory of this source tree.
 //
 // • `{{ .Header }}`
 // • `{{ .Footer }}`
+// • `{{ .Checks }}`
 // • `{{ .Inputs }}`
+// • `{{ .Migrations }}`
 // • `{{ .Modules }}`
 // • `{{ .Outputs }}`
 // • `{{ .Providers }}`
@@ -12,6 +12,7 @@ package template
 
 import (
 	"fmt"
+	"html"
 )
 
 // CreateAnchorMarkdown creates HTML anchor for Markdown format.
@@ -40,3 +41,98 @@ func CreateAnchorAsciidoc(prefix string, value string, anchor bool, escape bool)
 
 	return sanitizedName
 }
+
+// CreateAnchorHTML creates HTML anchor for HTML format. Escaping is not needed
+// in HTML, and all the values are HTML escaped instead.
+func CreateAnchorHTML(prefix string, value string, anchor bool) string {
+	name := html.EscapeString(value)
+
+	if anchor {
+		anchorName := html.EscapeString(fmt.Sprintf("%s_%s", prefix, value))
+		return fmt.Sprintf("<a id=\"%s\" href=\"#%s\">%s</a>", anchorName, anchorName, name)
+	}
+
+	return name
+}
+
+// CreateAnchorRST creates a label and a reference to it for reStructuredText
+// format. The label is placed on its own line, right before the reference.
+func CreateAnchorRST(prefix string, value string, anchor bool, escape bool) string {
+	if anchor {
+		label := fmt.Sprintf("%s_%s", prefix, value)
+		return fmt.Sprintf(".. _%s:\n\n:ref:`%s <%s>`", label, value, label)
+	}
+
+	return EscapeRSTCharacters(value, escape)
+}
+
+// CreateAnchorLinkMarkdown creates HTML anchor for Markdown format, like
+// CreateAnchorMarkdown does, with the value linked to 'url' instead of the
+// anchor itself. It's the same as CreateAnchorMarkdown if 'url' is empty.
+func CreateAnchorLinkMarkdown(prefix string, value string, url string, anchor bool, escape bool) string {
+	if url == "" {
+		return CreateAnchorMarkdown(prefix, value, anchor, escape)
+	}
+
+	link := fmt.Sprintf("[%s](%s)", SanitizeName(value, escape), url)
+
+	if anchor {
+		return fmt.Sprintf("<a name=\"%s_%s\"></a> %s", prefix, value, link)
+	}
+
+	return link
+}
+
+// CreateAnchorLinkAsciidoc creates HTML anchor for AsciiDoc format, like
+// CreateAnchorAsciidoc does, with the value linked to 'url' instead of the
+// anchor itself. It's the same as CreateAnchorAsciidoc if 'url' is empty.
+func CreateAnchorLinkAsciidoc(prefix string, value string, url string, anchor bool, escape bool) string {
+	if url == "" {
+		return CreateAnchorAsciidoc(prefix, value, anchor, escape)
+	}
+
+	link := fmt.Sprintf("%s[%s]", url, SanitizeName(value, escape))
+
+	if anchor {
+		anchorName := SanitizeName(fmt.Sprintf("%s_%s", prefix, value), escape)
+		return fmt.Sprintf("[[%s]] %s", anchorName, link)
+	}
+
+	return link
+}
+
+// CreateAnchorLinkHTML creates HTML anchor for HTML format, like
+// CreateAnchorHTML does, with the value linked to 'url' instead of the anchor
+// itself. It's the same as CreateAnchorHTML if 'url' is empty.
+func CreateAnchorLinkHTML(prefix string, value string, url string, anchor bool) string {
+	if url == "" {
+		return CreateAnchorHTML(prefix, value, anchor)
+	}
+
+	name := html.EscapeString(value)
+	href := html.EscapeString(url)
+
+	if anchor {
+		anchorName := html.EscapeString(fmt.Sprintf("%s_%s", prefix, value))
+		return fmt.Sprintf("<a id=\"%s\" href=\"%s\">%s</a>", anchorName, href, name)
+	}
+
+	return fmt.Sprintf("<a href=\"%s\">%s</a>", href, name)
+}
+
+// CreateAnchorLinkRST creates a label and a link for reStructuredText format,
+// like CreateAnchorRST does, with the value linked to 'url' instead of the
+// label. It's the same as CreateAnchorRST if 'url' is empty.
+func CreateAnchorLinkRST(prefix string, value string, url string, anchor bool, escape bool) string {
+	if url == "" {
+		return CreateAnchorRST(prefix, value, anchor, escape)
+	}
+
+	link := fmt.Sprintf("`%s <%s>`__", value, url)
+
+	if anchor {
+		return fmt.Sprintf(".. _%s_%s:\n\n%s", prefix, value, link)
+	}
+
+	return link
+}
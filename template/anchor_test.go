@@ -111,3 +111,236 @@ func TestAnchorAsciidoc(t *testing.T) {
 		})
 	}
 }
+
+func TestAnchorHTML(t *testing.T) {
+	tests := []struct {
+		typeSection string
+		name        string
+		anchor      bool
+		expected    string
+	}{
+		{
+			typeSection: "module",
+			name:        "banana_anchor",
+			anchor:      true,
+			expected:    "<a id=\"module_banana_anchor\" href=\"#module_banana_anchor\">banana_anchor</a>",
+		},
+		{
+			typeSection: "module",
+			name:        "banana_no_anchor",
+			anchor:      false,
+			expected:    "banana_no_anchor",
+		},
+		{
+			typeSection: "output",
+			name:        "<banana>",
+			anchor:      true,
+			expected:    "<a id=\"output_&lt;banana&gt;\" href=\"#output_&lt;banana&gt;\">&lt;banana&gt;</a>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := CreateAnchorHTML(tt.typeSection, tt.name, tt.anchor)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestAnchorRST(t *testing.T) {
+	tests := []struct {
+		typeSection string
+		name        string
+		anchor      bool
+		escape      bool
+		expected    string
+	}{
+		{
+			typeSection: "module",
+			name:        "banana_anchor",
+			anchor:      true,
+			escape:      true,
+			expected:    ".. _module_banana_anchor:\n\n:ref:`banana_anchor <module_banana_anchor>`",
+		},
+		{
+			typeSection: "module",
+			name:        "banana_no_anchor",
+			anchor:      false,
+			escape:      true,
+			expected:    "banana_no_anchor",
+		},
+		{
+			typeSection: "output",
+			name:        "banana_",
+			anchor:      false,
+			escape:      true,
+			expected:    "banana\\_",
+		},
+		{
+			typeSection: "output",
+			name:        "banana_",
+			anchor:      false,
+			escape:      false,
+			expected:    "banana_",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := CreateAnchorRST(tt.typeSection, tt.name, tt.anchor, tt.escape)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestAnchorLinkMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		anchor   bool
+		expected string
+	}{
+		{
+			name:     "banana_anchor",
+			url:      "https://example.com/banana",
+			anchor:   true,
+			expected: "<a name=\"provider_banana_anchor\"></a> [banana\\_anchor](https://example.com/banana)",
+		},
+		{
+			name:     "banana_no_anchor",
+			url:      "https://example.com/banana",
+			anchor:   false,
+			expected: "[banana\\_no\\_anchor](https://example.com/banana)",
+		},
+		{
+			name:     "banana_no_url",
+			url:      "",
+			anchor:   true,
+			expected: "<a name=\"provider_banana_no_url\"></a> [banana\\_no\\_url](#provider\\_banana\\_no\\_url)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := CreateAnchorLinkMarkdown("provider", tt.name, tt.url, tt.anchor, true)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestAnchorLinkAsciidoc(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		anchor   bool
+		expected string
+	}{
+		{
+			name:     "banana_anchor",
+			url:      "https://example.com/banana",
+			anchor:   true,
+			expected: "[[provider_banana_anchor]] https://example.com/banana[banana_anchor]",
+		},
+		{
+			name:     "banana_no_anchor",
+			url:      "https://example.com/banana",
+			anchor:   false,
+			expected: "https://example.com/banana[banana_no_anchor]",
+		},
+		{
+			name:     "banana_no_url",
+			url:      "",
+			anchor:   true,
+			expected: "[[provider_banana_no_url]] <<provider_banana_no_url,banana_no_url>>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := CreateAnchorLinkAsciidoc("provider", tt.name, tt.url, tt.anchor, false)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestAnchorLinkHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		anchor   bool
+		expected string
+	}{
+		{
+			name:     "banana_anchor",
+			url:      "https://example.com/?a=1&b=2",
+			anchor:   true,
+			expected: "<a id=\"provider_banana_anchor\" href=\"https://example.com/?a=1&amp;b=2\">banana_anchor</a>",
+		},
+		{
+			name:     "<banana>",
+			url:      "https://example.com/banana",
+			anchor:   false,
+			expected: "<a href=\"https://example.com/banana\">&lt;banana&gt;</a>",
+		},
+		{
+			name:     "banana_no_url",
+			url:      "",
+			anchor:   true,
+			expected: "<a id=\"provider_banana_no_url\" href=\"#provider_banana_no_url\">banana_no_url</a>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := CreateAnchorLinkHTML("provider", tt.name, tt.url, tt.anchor)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestAnchorLinkRST(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		anchor   bool
+		expected string
+	}{
+		{
+			name:     "banana_anchor",
+			url:      "https://example.com/banana",
+			anchor:   true,
+			expected: ".. _provider_banana_anchor:\n\n`banana_anchor <https://example.com/banana>`__",
+		},
+		{
+			name:     "banana_no_anchor",
+			url:      "https://example.com/banana",
+			anchor:   false,
+			expected: "`banana_no_anchor <https://example.com/banana>`__",
+		},
+		{
+			name:     "banana_no_url",
+			url:      "",
+			anchor:   false,
+			expected: "banana_no_url",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := CreateAnchorLinkRST("provider", tt.name, tt.url, tt.anchor, true)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
@@ -167,6 +167,166 @@ func SanitizeAsciidocTable(s string, escape bool, html bool) string {
 	return result
 }
 
+// SanitizeRST converts passed 'string' from Markdown to suitable reStructuredText
+// representation for a document or a table cell (including code spans, links,
+// code blocks etc).
+func SanitizeRST(s string, escape bool) string {
+	if s == "" {
+		return "n/a"
+	}
+	result := processSegments(
+		s,
+		"```",
+		func(segment string, first bool, last bool) string {
+			return ConvertRSTInline(segment, escape)
+		},
+		convertRSTFencedCode,
+	)
+	return rstBlankLinesRegex.ReplaceAllString(result, "\n\n")
+}
+
+// SanitizeRSTSection converts passed 'string' from Markdown to suitable
+// reStructuredText representation for a section (i.e. header and footer). In
+// addition to SanitizeRST it converts Markdown headings into reStructuredText
+// sections of the same level.
+func SanitizeRSTSection(s string, escape bool) string {
+	if s == "" {
+		return "n/a"
+	}
+	result := processSegments(
+		s,
+		"```",
+		func(segment string, first bool, last bool) string {
+			return executePerLine(segment, func(line string) string {
+				if match := rstHeadingRegex.FindStringSubmatch(line); match != nil {
+					return "\n" + createRSTHeading(ConvertRSTInline(match[2], escape), len(match[1]))
+				}
+				return ConvertRSTInline(line, escape)
+			})
+		},
+		convertRSTFencedCode,
+	)
+	return rstBlankLinesRegex.ReplaceAllString(result, "\n\n")
+}
+
+// convertRSTFencedCode converts the content of a Markdown fenced code block,
+// with its optional language on the first line, into 'code-block' directive.
+// Indentation of the code block (e.g. inside a list item) is removed.
+func convertRSTFencedCode(segment string, first bool, last bool) string {
+	language, code, found := strings.Cut(segment, "\n")
+	if !found {
+		return fmt.Sprintf("``%s``", segment)
+	}
+
+	lines := strings.Split(strings.TrimRight(code, " \n"), "\n")
+	indent := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if n := len(line) - len(strings.TrimLeft(line, " \t")); indent == -1 || n < indent {
+			indent = n
+		}
+	}
+	for i, line := range lines {
+		if len(line) >= indent && indent > 0 {
+			lines[i] = line[indent:]
+		}
+	}
+
+	return fmt.Sprintf("\n\n%s\n\n", ConvertRSTCodeBlock(strings.Join(lines, "\n"), strings.TrimSpace(language)))
+}
+
+// ConvertRSTCodeBlock converts a (multi-line) code into reStructuredText
+// 'code-block' directive, highlighted as 'language' if it's not empty.
+func ConvertRSTCodeBlock(code string, language string) string {
+	directive := ".. code-block::"
+	if language != "" {
+		directive += " " + language
+	}
+	lines := strings.Split(code, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			lines[i] = "   " + line
+		} else {
+			lines[i] = ""
+		}
+	}
+	return fmt.Sprintf("%s\n\n%s", directive, strings.Join(lines, "\n"))
+}
+
+var (
+	rstBlankLinesRegex = regexp.MustCompile(`\n([ \t]*\n){2,}`)
+	rstHeadingRegex    = regexp.MustCompile(`^\s*(#{1,6})\s+(.*?)\s*#*\s*$`)
+	rstEmphasisRegex   = regexp.MustCompile(`(^|\s)_([^_\s][^_]*?)_([\s.,;:!?)]|$)`)
+	rstLinkRegex       = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	rstTrailingRegex   = regexp.MustCompile(`(\w)_(\W|$)`)
+)
+
+// ConvertRSTInline converts the inline Markdown syntax of passed 'string' (i.e.
+// code spans, links and underscore emphasis) to their reStructuredText
+// equivalent, and escapes the characters which have special meaning in there.
+func ConvertRSTInline(s string, escape bool) string {
+	return processSegments(
+		s,
+		"`",
+		func(segment string, first bool, last bool) string {
+			segment = rstEmphasisRegex.ReplaceAllString(segment, "$1*$2*$3")
+			segment = EscapeRSTCharacters(segment, escape)
+			segment = NormalizeURLs(segment, escape)
+			segment = rstLinkRegex.ReplaceAllString(segment, "`$1 <$2>`__")
+			return segment
+		},
+		func(segment string, first bool, last bool) string {
+			return fmt.Sprintf("``%s``", segment)
+		},
+	)
+}
+
+// EscapeRSTCharacters escapes characters which have special meaning in
+// reStructuredText into their corresponding literal, i.e. backslash, pipe
+// (substitution) and trailing underscore (reference).
+func EscapeRSTCharacters(s string, escape bool) string {
+	if !escape {
+		return s
+	}
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, "|", "\\|")
+	s = rstTrailingRegex.ReplaceAllString(s, "$1\\_$2")
+	return s
+}
+
+// CreateRSTListTable creates reStructuredText 'list-table' directive with
+// passed 'header' cells as its header row. Rows are added right after it with
+// CreateRSTListTableRow.
+func CreateRSTListTable(header []string) string {
+	return ".. list-table::\n   :header-rows: 1\n\n" + CreateRSTListTableRow(header)
+}
+
+// CreateRSTListTableRow creates a row of reStructuredText 'list-table'
+// directive with passed 'cells'. Content of each cell can be multi-line (e.g.
+// 'code-block' directive) and is indented accordingly.
+func CreateRSTListTableRow(cells []string) string {
+	lines := []string{}
+	for i, cell := range cells {
+		marker := "     - "
+		if i == 0 {
+			marker = "   * - "
+		}
+		for j, line := range strings.Split(strings.Trim(cell, "\n"), "\n") {
+			switch {
+			case j == 0:
+				lines = append(lines, strings.TrimRight(marker+line, " "))
+			case strings.TrimSpace(line) == "":
+				lines = append(lines, "")
+			default:
+				lines = append(lines, "       "+line)
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
 // ConvertMultiLineText converts a multi-line text into a suitable Markdown representation.
 func ConvertMultiLineText(s string, isTable bool, isHeader bool, showHTML bool) string {
 	if isTable {
@@ -288,6 +288,179 @@ func TestSanitizeAsciidocTable(t *testing.T) {
 	}
 }
 
+func TestSanitizeRST(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		escape   bool
+	}{
+		{
+			name:     "sanitize rst item empty",
+			filename: "empty",
+			escape:   false,
+		},
+		{
+			name:     "sanitize rst item complex",
+			filename: "complex",
+			escape:   false,
+		},
+		{
+			name:     "sanitize rst item codeblock",
+			filename: "codeblock",
+			escape:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			bytes, err := os.ReadFile(filepath.Join("testdata", "table", tt.filename+".golden"))
+			assert.Nil(err)
+
+			actual := SanitizeRST(string(bytes), tt.escape)
+
+			expected, err := os.ReadFile(filepath.Join("testdata", "table", tt.filename+".rst.expected"))
+			assert.Nil(err)
+
+			assert.Equal(string(expected), actual)
+		})
+	}
+}
+
+func TestSanitizeRSTSection(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		escape   bool
+	}{
+		{
+			name:     "sanitize rst section empty",
+			filename: "empty",
+			escape:   true,
+		},
+		{
+			name:     "sanitize rst section complex",
+			filename: "complex",
+			escape:   true,
+		},
+		{
+			name:     "sanitize rst section codeblock",
+			filename: "codeblock",
+			escape:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			bytes, err := os.ReadFile(filepath.Join("testdata", "section", tt.filename+".golden"))
+			assert.Nil(err)
+
+			actual := SanitizeRSTSection(string(bytes), tt.escape)
+
+			expected, err := os.ReadFile(filepath.Join("testdata", "section", tt.filename+".rst.expected"))
+			assert.Nil(err)
+
+			assert.Equal(string(expected), actual)
+		})
+	}
+}
+
+func TestEscapeRSTCharacters(t *testing.T) {
+	tests := []struct {
+		name     string
+		param    string
+		escape   bool
+		expected string
+	}{
+		{
+			name:     "escape rst characters",
+			param:    "mixed | pipe, trailing_ reference and back\\slash",
+			escape:   true,
+			expected: "mixed \\| pipe, trailing\\_ reference and back\\\\slash",
+		},
+		{
+			name:     "escape rst characters",
+			param:    "internal_underscore, trailing_",
+			escape:   true,
+			expected: "internal_underscore, trailing\\_",
+		},
+		{
+			name:     "escape rst characters",
+			param:    "mixed | pipe and trailing_ reference",
+			escape:   false,
+			expected: "mixed | pipe and trailing_ reference",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := EscapeRSTCharacters(tt.param, tt.escape)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestConvertRSTInline(t *testing.T) {
+	tests := []struct {
+		name     string
+		param    string
+		escape   bool
+		expected string
+	}{
+		{
+			name:     "convert rst inline code",
+			param:    "Use `foo_` or `bar`.",
+			escape:   true,
+			expected: "Use ``foo_`` or ``bar``.",
+		},
+		{
+			name:     "convert rst inline link",
+			param:    "See [the docs](https://example.com/a_b) and https://example.com/c_d",
+			escape:   true,
+			expected: "See `the docs <https://example.com/a_b>`__ and https://example.com/c_d",
+		},
+		{
+			name:     "convert rst inline emphasis",
+			param:    "Inline **strong** and _emphasis_ text.",
+			escape:   true,
+			expected: "Inline **strong** and *emphasis* text.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := ConvertRSTInline(tt.param, tt.escape)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestCreateRSTListTable(t *testing.T) {
+	assert := assert.New(t)
+
+	actual := CreateRSTListTable([]string{"Name", "Version"}) + "\n" +
+		CreateRSTListTableRow([]string{"foo", ""}) + "\n" +
+		CreateRSTListTableRow([]string{"bar", "\n\n" + ConvertRSTCodeBlock("[\n\n  1\n]", "json") + "\n"})
+
+	expected := ".. list-table::\n" +
+		"   :header-rows: 1\n" +
+		"\n" +
+		"   * - Name\n" +
+		"     - Version\n" +
+		"   * - foo\n" +
+		"     -\n" +
+		"   * - bar\n" +
+		"     - .. code-block:: json\n" +
+		"\n" +
+		"          [\n" +
+		"\n" +
+		"            1\n" +
+		"          ]"
+
+	assert.Equal(expected, actual)
+}
+
 func TestConvertMultiLineText(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -15,6 +15,7 @@ import (
 	"fmt"
 	"strings"
 	gotemplate "text/template"
+	"unicode/utf8"
 
 	sprig "github.com/Masterminds/sprig/v3"
 
@@ -214,6 +215,12 @@ func builtinFuncs(config *print.Config) gotemplate.FuncMap { // nolint:gocyclo
 		"sanitizeAsciidocTbl": func(s string) string {
 			return SanitizeAsciidocTable(s, config.Settings.Escape, config.Settings.HTML)
 		},
+		"sanitizeRST": func(s string) string {
+			return SanitizeRST(s, config.Settings.Escape)
+		},
+		"sanitizeRSTSection": func(s string) string {
+			return SanitizeRSTSection(s, config.Settings.Escape)
+		},
 
 		// anchors
 		"anchorNameMarkdown": func(prefix string, value string) string {
@@ -222,6 +229,32 @@ func builtinFuncs(config *print.Config) gotemplate.FuncMap { // nolint:gocyclo
 		"anchorNameAsciidoc": func(prefix string, value string) string {
 			return CreateAnchorAsciidoc(prefix, value, config.Settings.Anchor, config.Settings.Escape)
 		},
+		"anchorNameHTML": func(prefix string, value string) string {
+			return CreateAnchorHTML(prefix, value, config.Settings.Anchor)
+		},
+		"anchorNameRST": func(prefix string, value string) string {
+			return CreateAnchorRST(prefix, value, config.Settings.Anchor, config.Settings.Escape)
+		},
+		"anchorLinkMarkdown": func(prefix string, value string, url string) string {
+			return CreateAnchorLinkMarkdown(prefix, value, url, config.Settings.Anchor, config.Settings.Escape)
+		},
+		"anchorLinkAsciidoc": func(prefix string, value string, url string) string {
+			return CreateAnchorLinkAsciidoc(prefix, value, url, config.Settings.Anchor, config.Settings.Escape)
+		},
+		"anchorLinkHTML": func(prefix string, value string, url string) string {
+			return CreateAnchorLinkHTML(prefix, value, url, config.Settings.Anchor)
+		},
+		"anchorLinkRST": func(prefix string, value string, url string) string {
+			return CreateAnchorLinkRST(prefix, value, url, config.Settings.Anchor, config.Settings.Escape)
+		},
+
+		// reStructuredText list-table
+		"listTableRST": func(header ...interface{}) string {
+			return CreateRSTListTable(toStrings(header))
+		},
+		"listTableRowRST": func(cells ...interface{}) string {
+			return CreateRSTListTableRow(toStrings(cells))
+		},
 	}
 
 	for name, fn := range sprig.FuncMap() {
@@ -233,6 +266,20 @@ func builtinFuncs(config *print.Config) gotemplate.FuncMap { // nolint:gocyclo
 	return fns
 }
 
+// toStrings flattens 'values', which can be strings or lists of them (e.g.
+// created with 'list' function), into a list of strings.
+func toStrings(values []interface{}) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if items, ok := v.([]interface{}); ok {
+			result = append(result, toStrings(items)...)
+			continue
+		}
+		result = append(result, fmt.Sprint(v))
+	}
+	return result
+}
+
 // normalize the template and remove any space from all the lines. This makes
 // it possible to have a indented, human-readable template which doesn't affect
 // the rendering of them.
@@ -287,3 +334,23 @@ func GenerateIndentation(base int, extra int, char string) string {
 	}
 	return indent
 }
+
+// rstHeadingChars are the characters used to underline reStructuredText
+// sections, from the top level to the lowest one.
+var rstHeadingChars = []string{"=", "-", "~", "^", "\"", "'", "+"}
+
+// GenerateRSTHeading generates reStructuredText section of 'title' with base
+// level of provided 'settings.IndentLevel' plus any extra level needed for
+// subsection (e.g. 'Required Inputs' which is a subsection of 'Inputs' section)
+func GenerateRSTHeading(base int, extra int, title string) string {
+	if base < 1 || base > 5 {
+		base = 2
+	}
+	return createRSTHeading(title, base+extra)
+}
+
+// createRSTHeading underlines 'title' with the character of 'level'.
+func createRSTHeading(title string, level int) string {
+	level = min(max(level, 1), len(rstHeadingChars))
+	return title + "\n" + strings.Repeat(rstHeadingChars[level-1], max(utf8.RuneCountInString(title), 1))
+}
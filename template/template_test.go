@@ -509,6 +509,48 @@ func TestGenerateIndentation(t *testing.T) {
 	}
 }
 
+func TestGenerateRSTHeading(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     int
+		extra    int
+		expected string
+	}{
+		{
+			name:     "generate rst heading",
+			base:     2,
+			extra:    1,
+			expected: "Inputs\n~~~~~~",
+		},
+		{
+			name:     "generate rst heading",
+			base:     1,
+			extra:    0,
+			expected: "Inputs\n======",
+		},
+		{
+			name:     "generate rst heading",
+			base:     0,
+			extra:    0,
+			expected: "Inputs\n------",
+		},
+		{
+			name:     "generate rst heading",
+			base:     5,
+			extra:    5,
+			expected: "Inputs\n++++++",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := GenerateRSTHeading(tt.base, tt.extra, "Inputs")
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
 func TestNormalize(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,222 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// block represents a raw HCL block together with the file it is declared in.
+// It is used to extract details of Terraform items which are not exposed by
+// terraform-config-inspect (e.g. variable validations).
+type block struct {
+	*hclsyntax.Block
+
+	file *hclFile
+}
+
+// hclFile is a Terraform file which is read and parsed once per module load.
+type hclFile struct {
+	src      []byte
+	body     *hclsyntax.Body // nil if the file can't be parsed
+	comments *comments       // lexed on first use, see block.comments
+}
+
+// hclFiles caches the parsed files of a module, keyed by their name, for all
+// the blocks of a file to be loaded without reading and parsing it again.
+type hclFiles map[string]*hclFile
+
+func newHCLFiles() hclFiles {
+	return make(hclFiles)
+}
+
+// load returns the parsed 'filename', reading and parsing it if it's not
+// cached yet. Its body is nil if the file can't be read or parsed (e.g. it's
+// a JSON configuration).
+func (f hclFiles) load(filename string) *hclFile {
+	if file, ok := f[filename]; ok {
+		return file
+	}
+	file := &hclFile{}
+	f[filename] = file
+
+	src, err := os.ReadFile(filepath.Clean(filename))
+	if err != nil {
+		return file // absorb the error, we don't need to bubble it up or break the execution
+	}
+	file.src = src
+	parsed, diags := hclsyntax.ParseConfig(src, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return file
+	}
+	if body, ok := parsed.Body.(*hclsyntax.Body); ok {
+		file.body = body
+	}
+	return file
+}
+
+// block returns the top-level block of type 'blockType' which is declared
+// at 'lineNum' of 'filename'. It returns nil if the file can't be parsed (e.g.
+// it's a JSON configuration) or if no such block exists.
+func (f hclFiles) block(filename string, lineNum int, blockType string) *block {
+	for _, b := range f.blocks(filename, blockType) {
+		if b.DefRange().Start.Line == lineNum {
+			return b
+		}
+	}
+	return nil
+}
+
+// blocks returns all the top-level blocks of any of 'blockTypes' declared
+// in 'filename', in the order they are declared. It returns an empty list if the
+// file can't be parsed (e.g. it's a JSON configuration).
+func (f hclFiles) blocks(filename string, blockTypes ...string) []*block {
+	blocks := make([]*block, 0)
+
+	file := f.load(filename)
+	if file.body == nil {
+		return blocks
+	}
+	for _, b := range file.body.Blocks {
+		if slices.Contains(blockTypes, b.Type) {
+			blocks = append(blocks, &block{Block: b, file: file})
+		}
+	}
+	return blocks
+}
+
+// boolean returns the value of the boolean attribute of the block with the
+// given name, or 'fallback' if it's not set or can't be evaluated.
+func (b *block) boolean(name string, fallback bool) bool {
+	if b == nil {
+		return fallback
+	}
+	attr, ok := b.attribute(name)
+	if !ok {
+		return fallback
+	}
+	var value bool
+	if diags := gohcl.DecodeExpression(attr.Expr, nil, &value); diags.HasErrors() {
+		return fallback
+	}
+	return value
+}
+
+// attribute returns the attribute of the block with the given name.
+func (b *block) attribute(name string) (*hclsyntax.Attribute, bool) {
+	attr, ok := b.Body.Attributes[name]
+	return attr, ok
+}
+
+// blocks returns all the nested blocks of any of the given types.
+func (b *block) blocks(blockTypes ...string) []*block {
+	blocks := make([]*block, 0)
+	for _, nested := range b.Body.Blocks {
+		if slices.Contains(blockTypes, nested.Type) {
+			blocks = append(blocks, &block{Block: nested, file: b.file})
+		}
+	}
+	return blocks
+}
+
+// source returns the raw source of the given expression as it's written in
+// the Terraform file.
+func (b *block) source(expr hclsyntax.Expression) string {
+	return strings.TrimSpace(string(expr.Range().SliceBytes(b.file.src)))
+}
+
+// text returns the string value of the given expression if it's a literal,
+// otherwise its raw source (without surrounding quotes) is returned.
+func (b *block) text(expr hclsyntax.Expression) string {
+	var value string
+	if diags := gohcl.DecodeExpression(expr, nil, &value); !diags.HasErrors() {
+		return value
+	}
+	value = b.source(expr)
+	if len(value) > 1 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+		value = value[1 : len(value)-1]
+	}
+	return value
+}
+
+// comments holds the comments of a file, keyed by the line they start at.
+type comments struct {
+	leading  map[int]string // comments on a line of their own
+	trailing map[int]string // comments following some code on the same line
+}
+
+// comments returns all the comments of the file the block is declared in.
+// The file is lexed only once, the first time its comments are needed.
+func (b *block) comments() *comments {
+	if b.file.comments != nil {
+		return b.file.comments
+	}
+	c := &comments{
+		leading:  make(map[int]string),
+		trailing: make(map[int]string),
+	}
+	tokens, _ := hclsyntax.LexConfig(b.file.src, "", hcl.InitialPos)
+	code := 0 // last line containing anything other than comments
+	for _, token := range tokens {
+		switch token.Type { //nolint:exhaustive
+		case hclsyntax.TokenComment:
+			line := token.Range.Start.Line
+			text := commentText(string(token.Bytes))
+			if line == code {
+				c.trailing[line] = text
+			} else {
+				c.leading[line] = text
+			}
+		case hclsyntax.TokenNewline, hclsyntax.TokenEOF:
+		default:
+			code = token.Range.End.Line
+		}
+	}
+	b.file.comments = c
+	return c
+}
+
+// attached returns the comment attached to the given line, i.e. the comment
+// lines immediately before it or, if there's none, the comment following it.
+func (c *comments) attached(lineNum int) string {
+	lines := make([]string, 0)
+	for l := lineNum - 1; l > 0; l-- {
+		text, ok := c.leading[l]
+		if !ok {
+			break
+		}
+		lines = append([]string{text}, lines...)
+	}
+	if len(lines) == 0 {
+		return c.trailing[lineNum]
+	}
+	return strings.Join(lines, " ")
+}
+
+func commentText(s string) string {
+	s = strings.TrimSpace(s)
+	switch {
+	case strings.HasPrefix(s, "#"):
+		s = strings.TrimPrefix(s, "#")
+	case strings.HasPrefix(s, "//"):
+		s = strings.TrimPrefix(s, "//")
+	case strings.HasPrefix(s, "/*"):
+		s = strings.TrimSuffix(strings.TrimPrefix(s, "/*"), "*/")
+	}
+	return strings.Join(strings.Fields(s), " ")
+}
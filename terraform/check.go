@@ -0,0 +1,56 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"fmt"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// Check types.
+const (
+	CheckAssert        = "assert"
+	CheckPrecondition  = "precondition"
+	CheckPostcondition = "postcondition"
+)
+
+// Check represents an assertion of the module, declared either in a 'check'
+// block or as a precondition or postcondition of a resource, data source or
+// output.
+type Check struct {
+	Type         string       `json:"type" toml:"type" xml:"type" yaml:"type"`
+	Owner        string       `json:"owner" toml:"owner" xml:"owner" yaml:"owner"`
+	Condition    string       `json:"condition" toml:"condition" xml:"condition" yaml:"condition"`
+	ErrorMessage types.String `json:"error_message" toml:"error_message" xml:"error_message" yaml:"error_message"`
+	File         string       `json:"file" toml:"file" xml:"file" yaml:"file"`
+	Line         int          `json:"line" toml:"line" xml:"line" yaml:"line"`
+}
+
+// Location returns the file and line the check is declared at, in the form
+// of 'file:line'.
+func (c *Check) Location() string {
+	return fmt.Sprintf("%s:%d", c.File, c.Line)
+}
+
+// checkOwner returns the address of the item owning the checks of block 'b',
+// e.g. 'check.health', 'aws_instance.main', 'data.aws_ami.main' or
+// 'output.id'.
+func checkOwner(b *block) string {
+	switch b.Type {
+	case "resource":
+		return fmt.Sprintf("%s.%s", b.Labels[0], b.Labels[1])
+	case "data":
+		return fmt.Sprintf("data.%s.%s", b.Labels[0], b.Labels[1])
+	default:
+		return fmt.Sprintf("%s.%s", b.Type, b.Labels[0])
+	}
+}
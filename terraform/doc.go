@@ -16,8 +16,12 @@ the root directory of this source tree.
 //
 // • Footer:        Module footer found in shape of multi line '*.tf' comments or an entire file
 //
+// • Checks:        List of 'check' block assertions and 'precondition' and 'postcondition' blocks extracted from the Terraform module .tf files
+//
 // • Inputs:        List of input 'variables' extracted from the Terraform module .tf files
 //
+// • Migrations:    List of 'moved', 'removed' and 'import' blocks extracted from the Terraform module .tf files
+//
 // • ModuleCalls:   List of 'modules' extracted from the Terraform module .tf files
 //
 // • Outputs:       List of 'outputs' extracted from Terraform module .tf files
@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+// loadInputExamples sets example value of 'inputs' from the tfvars files of
+// 'example-values.from' (or the ones Terraform loads automatically from module
+// root, if not set). Values of latter files override the former ones.
+func loadInputExamples(inputs []*Input, config *print.Config) error {
+	if !config.ExampleValues.Enabled {
+		return nil
+	}
+
+	files, err := exampleFiles(config)
+	if err != nil {
+		return err
+	}
+
+	values := map[string]types.Value{}
+	for _, file := range files {
+		if err := loadTfvars(file, values); err != nil {
+			return err
+		}
+	}
+
+	for _, input := range inputs {
+		// values of sensitive inputs are likely real secrets
+		if input.Sensitive {
+			continue
+		}
+		if value, ok := values[input.Name]; ok {
+			input.Example = value
+		}
+	}
+
+	return nil
+}
+
+// exampleFiles returns the tfvars files to read example values from, in the
+// order they should be read. Items of 'example-values.from' are relative to
+// module root, and directories (e.g. examples/) are searched recursively.
+// If it's empty, the files which Terraform loads automatically are used,
+// i.e. terraform.tfvars, terraform.tfvars.json and *.auto.tfvars(.json).
+func exampleFiles(config *print.Config) ([]string, error) {
+	if len(config.ExampleValues.From) == 0 {
+		files := []string{}
+		for _, name := range []string{"terraform.tfvars", "terraform.tfvars.json"} {
+			file := filepath.Join(config.ModuleRoot, name)
+			if _, err := os.Stat(file); err == nil {
+				files = append(files, file)
+			}
+		}
+		auto := []string{}
+		for _, pattern := range []string{"*.auto.tfvars", "*.auto.tfvars.json"} {
+			matches, err := filepath.Glob(filepath.Join(config.ModuleRoot, pattern))
+			if err != nil {
+				return nil, err
+			}
+			auto = append(auto, matches...)
+		}
+		sort.Strings(auto)
+		return append(files, auto...), nil
+	}
+
+	files := []string{}
+	for _, from := range config.ExampleValues.From {
+		path := from
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(config.ModuleRoot, from)
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("caught error while reading the example values at %s: %w", from, err)
+		}
+
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		err = filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && isTfvarsFile(d.Name()) {
+				files = append(files, file)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("caught error while reading the example values at %s: %w", from, err)
+		}
+	}
+
+	return files, nil
+}
+
+func isTfvarsFile(name string) bool {
+	return strings.HasSuffix(name, ".tfvars") || strings.HasSuffix(name, ".tfvars.json")
+}
+
+// loadTfvars reads the variables values of tfvars 'filename', either in HCL
+// or JSON syntax, into 'values'.
+func loadTfvars(filename string, values map[string]types.Value) error {
+	parser := hclparse.NewParser()
+
+	var file *hcl.File
+	var diags hcl.Diagnostics
+	if strings.HasSuffix(filename, ".json") {
+		file, diags = parser.ParseJSONFile(filename)
+	} else {
+		file, diags = parser.ParseHCLFile(filename)
+	}
+	if diags.HasErrors() {
+		return fmt.Errorf("caught error while reading the example values at %s: %w", filename, diags)
+	}
+
+	attributes, diags := file.Body.JustAttributes()
+	if diags.HasErrors() {
+		return fmt.Errorf("caught error while reading the example values at %s: %w", filename, diags)
+	}
+
+	for name, attribute := range attributes {
+		value, diags := attribute.Expr.Value(nil)
+		if diags.HasErrors() {
+			return fmt.Errorf("caught error while reading the example values at %s: %w", filename, diags)
+		}
+
+		raw, err := ctyjson.SimpleJSONValue{Value: value}.MarshalJSON()
+		if err != nil {
+			return fmt.Errorf("caught error while reading the example value of %s at %s: %w", name, filename, err)
+		}
+
+		var v interface{}
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return fmt.Errorf("caught error while reading the example value of %s at %s: %w", name, filename, err)
+		}
+
+		values[name] = types.ValueOf(v)
+	}
+
+	return nil
+}
@@ -22,27 +22,31 @@ import (
 
 // Input represents a Terraform input.
 type Input struct {
-	Name        string       `json:"name" toml:"name" xml:"name" yaml:"name"`
-	Type        types.String `json:"type" toml:"type" xml:"type" yaml:"type"`
-	Description types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
-	Default     types.Value  `json:"default" toml:"default" xml:"default" yaml:"default"`
-	Required    bool         `json:"required" toml:"required" xml:"required" yaml:"required"`
-	Position    Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
+	Name        string        `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Type        types.String  `json:"type" toml:"type" xml:"type" yaml:"type"`
+	TypeSchema  *TypeSchema   `json:"type_schema" toml:"type_schema" xml:"type_schema" yaml:"type_schema"`
+	Description types.String  `json:"description" toml:"description" xml:"description" yaml:"description"`
+	Default     types.Value   `json:"default" toml:"default" xml:"default" yaml:"default"`
+	Example     types.Value   `json:"example" toml:"example" xml:"example" yaml:"example"`
+	Required    bool          `json:"required" toml:"required" xml:"required" yaml:"required"`
+	Sensitive   bool          `json:"sensitive" toml:"sensitive" xml:"sensitive" yaml:"sensitive"`
+	Nullable    bool          `json:"nullable" toml:"nullable" xml:"nullable" yaml:"nullable"`
+	Ephemeral   bool          `json:"ephemeral" toml:"ephemeral" xml:"ephemeral" yaml:"ephemeral"`
+	Validations []*Validation `json:"validations" toml:"validations" xml:"validations>validation" yaml:"validations"`
+	Position    Position      `json:"-" toml:"-" xml:"-" yaml:"-"`
+}
+
+// Validation represents a validation rule of a Terraform input.
+type Validation struct {
+	Condition    string       `json:"condition" toml:"condition" xml:"condition" yaml:"condition"`
+	ErrorMessage types.String `json:"error_message" toml:"error_message" xml:"error_message" yaml:"error_message"`
 }
 
 // GetValue returns JSON representation of the 'Default' value, which is an 'interface'.
 // If 'Default' is a primitive type, the primitive value of 'Default' will be returned
 // and not the JSON formatted of it.
 func (i *Input) GetValue() string {
-	var buf bytes.Buffer
-	encoder := json.NewEncoder(&buf)
-	encoder.SetIndent("", "  ")
-	encoder.SetEscapeHTML(false)
-	err := encoder.Encode(i.Default)
-	if err != nil {
-		panic(err)
-	}
-	value := strings.TrimSpace(buf.String())
+	value := encodeValue(i.Default)
 	if value == `null` {
 		if i.Required {
 			return ""
@@ -52,11 +56,58 @@ func (i *Input) GetValue() string {
 	return value // everything else
 }
 
+// GetExample returns JSON representation of the 'Example' value, similar to
+// GetValue. It returns empty string if there's no example value.
+func (i *Input) GetExample() string {
+	if !i.HasExample() {
+		return ""
+	}
+	return encodeValue(i.Example)
+}
+
+// HasExample indicates if a Terraform variable has an example value, read
+// from tfvars files.
+func (i *Input) HasExample() bool {
+	return i.Example != nil && i.Example.HasDefault()
+}
+
+func encodeValue(v types.Value) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	err := encoder.Encode(v)
+	if err != nil {
+		panic(err)
+	}
+	return strings.TrimSpace(buf.String())
+}
+
 // HasDefault indicates if a Terraform variable has a default value set.
 func (i *Input) HasDefault() bool {
 	return i.Default.HasDefault() || !i.Required
 }
 
+// HasAttributeDescriptions indicates if any attribute of a Terraform variable
+// of object type is documented.
+func (i *Input) HasAttributeDescriptions() bool {
+	return i.TypeSchema != nil && i.TypeSchema.HasAttributeDescriptions()
+}
+
+// NestedAttributes returns the flattened list of attributes of a Terraform
+// variable of object type.
+func (i *Input) NestedAttributes() []*NestedAttribute {
+	if i.TypeSchema == nil {
+		return []*NestedAttribute{}
+	}
+	return i.TypeSchema.NestedAttributes()
+}
+
+// HasValidations indicates if a Terraform variable has validation rules.
+func (i *Input) HasValidations() bool {
+	return len(i.Validations) > 0
+}
+
 func sortInputsByName(x []*Input) {
 	sort.Slice(x, func(i, j int) bool {
 		return x[i].Name < x[j].Name
@@ -18,6 +18,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -65,20 +66,30 @@ func loadModuleItems(tfmodule *tfconfig.Module, config *print.Config) (*Module,
 		return nil, err
 	}
 
-	inputs, required, optional := loadInputs(tfmodule, config)
-	modulecalls := loadModulecalls(tfmodule, config)
+	// files are parsed once and shared by all the items declared in them
+	files := newHCLFiles()
+
+	checks := loadChecks(config, files)
+	inputs, required, optional := loadInputs(tfmodule, config, files)
+	if err := loadInputExamples(inputs, config); err != nil {
+		return nil, err
+	}
+	migrations := loadMigrations(config, files)
+	modulecalls := loadModulecalls(tfmodule, config, files)
 	outputs, err := loadOutputs(tfmodule, config)
 	if err != nil {
 		return nil, err
 	}
 	providers := loadProviders(tfmodule, config)
 	requirements := loadRequirements(tfmodule)
-	resources := loadResources(tfmodule, config)
+	resources := loadResources(tfmodule, config, files)
 
 	return &Module{
 		Header:       header,
 		Footer:       footer,
+		Checks:       checks,
 		Inputs:       inputs,
+		Migrations:   migrations,
 		ModuleCalls:  modulecalls,
 		Outputs:      outputs,
 		Providers:    providers,
@@ -129,61 +140,87 @@ func loadFooter(config *print.Config) (string, error) {
 	return loadSection(config, config.FooterFrom, "footer")
 }
 
-func loadSection(config *print.Config, file string, section string) (string, error) { //nolint:gocyclo
-	// NOTE(khos2ow): this function is over our cyclomatic complexity goal.
-	// Be wary when adding branches, and look for functionality that could
-	// be reasonably moved into an injected dependency.
-
+// loadSection returns the content of header or footer, concatenated from all
+// of the comma separated sources of 'file' (e.g. 'main.tf,docs/usage.md').
+func loadSection(config *print.Config, file string, section string) (string, error) {
 	if section == "" {
 		return "", errors.New("section is missing")
 	}
-	filename := filepath.Join(config.ModuleRoot, file)
-	if ok, err := isFileFormatSupported(file, section); !ok {
+	sources := parseSectionSources(file)
+	if len(sources) == 0 {
+		_, err := isFileFormatSupported("", section)
 		return "", err
 	}
+	var contents = make([]string, 0, len(sources))
+	for _, source := range sources {
+		content, err := loadSectionSource(config, source, section)
+		if err != nil {
+			return "", err
+		}
+		if content != "" {
+			contents = append(contents, content)
+		}
+	}
+	if len(contents) == 1 {
+		return contents[0], nil
+	}
+	for i := range contents {
+		contents[i] = strings.TrimRight(contents[i], "\r\n")
+	}
+	return strings.Join(contents, "\n\n"), nil
+}
+
+// loadSectionSource returns the content of a single source of header or
+// footer, i.e. a named region or a Markdown heading of any file if a name is
+// given, otherwise the comment at the top of a '.tf' or '.tofu' file or the
+// whole content of other files, without any YAML front matter. The line
+// comments at the top of a '.tf' or '.tofu' file are only read with their
+// 'comments' name (e.g. 'main.tf#comments').
+func loadSectionSource(config *print.Config, source sectionSource, section string) (string, error) {
+	filename := filepath.Join(config.ModuleRoot, source.file)
+	if source.name == "" {
+		if ok, err := isFileFormatSupported(source.file, section); !ok {
+			return "", err
+		}
+	}
 	if info, err := os.Stat(filename); os.IsNotExist(err) || info.IsDir() {
-		if section == "header" && file == "main.tf" {
+		if section == "header" && source.String() == "main.tf" {
 			return "", nil // absorb the error to not break workflow for default value of header and missing 'main.tf'
 		}
 		return "", err // user explicitly asked for a file which doesn't exist
 	}
-	format := getFileFormat(file)
-	if format != ".tf" && format != ".tofu" {
-		content, err := os.ReadFile(filepath.Clean(filename))
+	format := getFileFormat(source.file)
+	lineComments := source.name == lineCommentsSource
+	if (source.name == "" || lineComments) && (format == ".tf" || format == ".tofu") {
+		lines := headerComment(filename, lineComments)
+		sectionText, err := lines.Extract()
 		if err != nil {
 			return "", err
 		}
-		return string(content), nil
+		return strings.Join(sectionText, "\n"), nil
 	}
-	lines := reader.Lines{
-		FileName: filename,
-		LineNum:  -1,
-		Condition: func(line string) bool {
-			line = strings.TrimSpace(line)
-			return strings.HasPrefix(line, "/*") || strings.HasPrefix(line, "*") || strings.HasPrefix(line, "*/")
-		},
-		Parser: func(line string) (string, bool) {
-			tmp := strings.TrimSpace(line)
-			if strings.HasPrefix(tmp, "/*") || strings.HasPrefix(tmp, "*/") {
-				return "", false
-			}
-			if tmp == "*" {
-				return "", true
-			}
-			line = strings.TrimLeft(line, " ")
-			line = strings.TrimRight(line, "\r\n")
-			line = strings.TrimPrefix(line, "* ")
-			return line, true
-		},
-	}
-	sectionText, err := lines.Extract()
+	content, err := os.ReadFile(filepath.Clean(filename))
 	if err != nil {
 		return "", err
 	}
-	return strings.Join(sectionText, "\n"), nil
+	text := stripFrontMatter(string(content))
+	if source.name == "" {
+		return text, nil
+	}
+	region, found, err := extractRegion(text, source.name)
+	if err != nil {
+		return "", fmt.Errorf("%s in %s to read %s from", err, source.file, section)
+	}
+	if !found {
+		region, found = extractHeading(text, source.name)
+	}
+	if !found {
+		return "", fmt.Errorf("region or heading '%s' not found in %s to read %s from", source.name, source.file, section)
+	}
+	return region, nil
 }
 
-func loadInputs(tfmodule *tfconfig.Module, config *print.Config) ([]*Input, []*Input, []*Input) {
+func loadInputs(tfmodule *tfconfig.Module, config *print.Config, files hclFiles) ([]*Input, []*Input, []*Input) {
 	var inputs = make([]*Input, 0, len(tfmodule.Variables))
 	var required = make([]*Input, 0, len(tfmodule.Variables))
 	var optional = make([]*Input, 0, len(tfmodule.Variables))
@@ -202,12 +239,20 @@ func loadInputs(tfmodule *tfconfig.Module, config *print.Config) ([]*Input, []*I
 			inputDescription = comments
 		}
 
+		b := files.block(input.Pos.Filename, input.Pos.Line, "variable")
+
 		i := &Input{
 			Name:        input.Name,
 			Type:        types.TypeOf(input.Type, input.Default),
+			TypeSchema:  loadTypeSchema(b),
 			Description: types.String(inputDescription),
 			Default:     types.ValueOf(input.Default),
+			Example:     types.ValueOf(nil),
 			Required:    input.Required,
+			Sensitive:   input.Sensitive,
+			Nullable:    b.boolean("nullable", true),
+			Ephemeral:   b.boolean("ephemeral", false),
+			Validations: loadValidations(b),
 			Position: Position{
 				Filename: input.Pos.Filename,
 				Line:     input.Pos.Line,
@@ -226,6 +271,124 @@ func loadInputs(tfmodule *tfconfig.Module, config *print.Config) ([]*Input, []*I
 	return inputs, required, optional
 }
 
+func loadValidations(b *block) []*Validation {
+	validations := make([]*Validation, 0)
+
+	if b == nil {
+		return validations
+	}
+
+	for _, v := range b.blocks("validation") {
+		validation := &Validation{}
+		if attr, ok := v.attribute("condition"); ok {
+			validation.Condition = v.source(attr.Expr)
+		}
+		if attr, ok := v.attribute("error_message"); ok {
+			validation.ErrorMessage = types.String(strings.ReplaceAll(v.text(attr.Expr), "\r\n", "\n"))
+		}
+		validations = append(validations, validation)
+	}
+	return validations
+}
+
+func loadChecks(config *print.Config, files hclFiles) []*Check {
+	var checks = make([]*Check, 0)
+
+	for _, filename := range loadFiles(config.ModuleRoot) {
+		for _, b := range files.blocks(filename, "check", "resource", "data", "output") {
+			comments := loadComments(filename, b.DefRange().Start.Line)
+
+			// skip over checks of items that are marked as being ignored
+			if strings.Contains(comments, "terraform-docs-ignore") {
+				continue
+			}
+
+			var conditions []*block
+			switch b.Type {
+			case "check":
+				conditions = b.blocks(CheckAssert)
+			case "output":
+				conditions = b.blocks(CheckPrecondition)
+			default:
+				for _, lifecycle := range b.blocks("lifecycle") {
+					conditions = append(conditions, lifecycle.blocks(CheckPrecondition, CheckPostcondition)...)
+				}
+			}
+
+			for _, c := range conditions {
+				check := &Check{
+					Type:  c.Type,
+					Owner: checkOwner(b),
+					File:  filepath.Base(filename),
+					Line:  c.DefRange().Start.Line,
+				}
+				if attr, ok := c.attribute("condition"); ok {
+					check.Condition = c.source(attr.Expr)
+				}
+				if attr, ok := c.attribute("error_message"); ok {
+					check.ErrorMessage = types.String(strings.ReplaceAll(c.text(attr.Expr), "\r\n", "\n"))
+				}
+				checks = append(checks, check)
+			}
+		}
+	}
+	return checks
+}
+
+func loadMigrations(config *print.Config, files hclFiles) []*Migration {
+	var migrations = make([]*Migration, 0)
+
+	for _, filename := range loadFiles(config.ModuleRoot) {
+		for _, b := range files.blocks(filename, MigrationMoved, MigrationRemoved, MigrationImport) {
+			line := b.DefRange().Start.Line
+			comments := loadComments(filename, line)
+
+			// skip over migrations that are marked as being ignored
+			if strings.Contains(comments, "terraform-docs-ignore") {
+				continue
+			}
+
+			description := ""
+			if config.Settings.ReadComments {
+				description = comments
+			}
+
+			migration := &Migration{
+				Type:        b.Type,
+				Description: types.String(description),
+				File:        filepath.Base(filename),
+				Line:        line,
+			}
+			if attr, ok := b.attribute("from"); ok {
+				migration.From = b.source(attr.Expr)
+			}
+			if attr, ok := b.attribute("to"); ok {
+				migration.To = b.source(attr.Expr)
+			}
+			if attr, ok := b.attribute("id"); ok {
+				migration.ID = b.text(attr.Expr)
+			}
+			migrations = append(migrations, migration)
+		}
+	}
+	return migrations
+}
+
+// loadFiles returns the Terraform files of the module at 'path', sorted by
+// their names.
+func loadFiles(path string) []string {
+	var files []string
+	for _, pattern := range []string{"*.tf", "*.tofu"} {
+		matches, err := filepath.Glob(filepath.Join(path, pattern))
+		if err != nil {
+			continue
+		}
+		files = append(files, matches...)
+	}
+	sort.Strings(files)
+	return files
+}
+
 func formatSource(s, v string) (source, version string) {
 	substr := "?ref="
 
@@ -249,7 +412,20 @@ func formatSource(s, v string) (source, version string) {
 	return source, version
 }
 
-func loadModulecalls(tfmodule *tfconfig.Module, config *print.Config) []*ModuleCall {
+func loadModulecalls(tfmodule *tfconfig.Module, config *print.Config, files hclFiles) []*ModuleCall {
+	root, err := filepath.Abs(config.ModuleRoot)
+	if err != nil {
+		root = config.ModuleRoot
+	}
+	return loadNestedModulecalls(tfmodule, config, files, "", []string{root})
+}
+
+// loadNestedModulecalls returns the module calls of 'tfmodule', whose address
+// is 'parent' (empty for the root module). With 'settings.nested-resources'
+// the resources and module calls of local modules are loaded recursively,
+// 'ancestors' are the absolute paths of the modules already being loaded, to
+// not get stuck in a cycle.
+func loadNestedModulecalls(tfmodule *tfconfig.Module, config *print.Config, files hclFiles, parent string, ancestors []string) []*ModuleCall {
 	var modules = make([]*ModuleCall, 0)
 	var source, version string
 
@@ -268,20 +444,89 @@ func loadModulecalls(tfmodule *tfconfig.Module, config *print.Config) []*ModuleC
 
 		source, version = formatSource(m.Source, m.Version)
 
-		modules = append(modules, &ModuleCall{
-			Name:        m.Name,
-			Source:      source,
-			Version:     version,
-			Description: types.String(description),
+		modulecall := &ModuleCall{
+			Name:         m.Name,
+			Source:       source,
+			Version:      version,
+			Description:  types.String(description),
+			MetaArgument: loadMetaArgument(files, m.Pos.Filename, m.Pos.Line, "module"),
 			Position: Position{
 				Filename: m.Pos.Filename,
 				Line:     m.Pos.Line,
 			},
-		})
+		}
+
+		if config.Settings.NestedResources && isLocalSource(m.Source) {
+			loadNestedModule(modulecall, m.Source, config, files, parent, ancestors)
+		}
+
+		modules = append(modules, modulecall)
 	}
 	return modules
 }
 
+// loadNestedModule loads the resources and module calls of local module of
+// 'modulecall' declared in 'source' directory (relative to the module root).
+// It absorbs the errors, and leaves the module empty if it can't be loaded.
+func loadNestedModule(modulecall *ModuleCall, source string, config *print.Config, files hclFiles, parent string, ancestors []string) {
+	modulecall.Address = address(parent, "module."+modulecall.Name, modulecall.MetaArgument)
+	modulecall.Resources = make([]*Resource, 0)
+	modulecall.ModuleCalls = make([]*ModuleCall, 0)
+
+	path := filepath.Join(config.ModuleRoot, filepath.FromSlash(source))
+	abs, err := filepath.Abs(path)
+	if err != nil || slices.Contains(ancestors, abs) {
+		return
+	}
+	tfmodule, err := loadModule(path)
+	if err != nil {
+		return
+	}
+
+	nested := *config
+	nested.ModuleRoot = path
+
+	modulecall.Resources = loadNestedResources(tfmodule, &nested, files, modulecall.Address)
+	modulecall.ModuleCalls = loadNestedModulecalls(tfmodule, &nested, files, modulecall.Address, append(slices.Clone(ancestors), abs))
+
+	resources(modulecall.Resources).sort(config.Sort.Enabled, config.Sort.By)
+	modulecalls(modulecall.ModuleCalls).sort(config.Sort.Enabled, config.Sort.By)
+}
+
+// isLocalSource returns whether 'source' of a module call is a local path.
+func isLocalSource(source string) bool {
+	return strings.HasPrefix(source, "./") || strings.HasPrefix(source, "../")
+}
+
+// loadMetaArgument returns the meta-argument which repeats the block of type
+// 'blockType' declared at 'lineNum' of 'filename', i.e. 'count' or 'for_each',
+// or empty if it isn't repeated.
+func loadMetaArgument(files hclFiles, filename string, lineNum int, blockType string) string {
+	b := files.block(filename, lineNum, blockType)
+	if b == nil {
+		return ""
+	}
+	for _, name := range []string{"count", "for_each"} {
+		if _, ok := b.attribute(name); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// address returns the absolute address of an item named 'name' in module
+// 'parent', e.g. 'module.network.aws_vpc.this[*]', where '[*]' stands for
+// any index (or key) if the item is repeated by 'metaArgument'.
+func address(parent string, name string, metaArgument string) string {
+	if metaArgument != "" {
+		name += "[*]"
+	}
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
 func loadOutputs(tfmodule *tfconfig.Module, config *print.Config) ([]*Output, error) {
 	outputs := make([]*Output, 0, len(tfmodule.Outputs))
 	values := make(map[string]*output)
@@ -353,35 +598,15 @@ func loadOutputValues(config *print.Config) (map[string]*output, error) {
 	return terraformOutputs, err
 }
 
-func loadProviders(tfmodule *tfconfig.Module, config *print.Config) []*Provider { //nolint:gocyclo
-	// NOTE(khos2ow): this function is over our cyclomatic complexity goal.
-	// Be wary when adding branches, and look for functionality that could
-	// be reasonably moved into an injected dependency.
+func loadProviders(tfmodule *tfconfig.Module, config *print.Config) []*Provider {
+	lock := loadLockFile(config)
 
-	type provider struct {
-		Name        string   `hcl:"name,label"`
-		Version     string   `hcl:"version"`
-		Constraints *string  `hcl:"constraints"`
-		Hashes      []string `hcl:"hashes"`
-	}
-	type lockfile struct {
-		Provider []provider `hcl:"provider,block"`
-	}
-	lock := make(map[string]provider)
-
-	if config.Settings.LockFile {
-		var lf lockfile
-
-		filename := filepath.Join(config.ModuleRoot, ".terraform.lock.hcl")
-		if err := hclsimple.DecodeFile(filename, nil, &lf); err == nil {
-			for i := range lf.Provider {
-				segments := strings.Split(lf.Provider[i].Name, "/")
-				name := segments[len(segments)-1]
-				lock[name] = lf.Provider[i]
-			}
-		}
+	host := config.Registry.Host
+	if host == "" {
+		host = print.DefaultRegistryHost
 	}
 
+	resolver := loadRegistry(config)
 	resources := []map[string]*tfconfig.Resource{tfmodule.ManagedResources, tfmodule.DataResources}
 	discovered := make(map[string]*Provider)
 
@@ -394,13 +619,6 @@ func loadProviders(tfmodule *tfconfig.Module, config *print.Config) []*Provider
 				continue
 			}
 
-			var version = ""
-			if l, ok := lock[r.Provider.Name]; ok {
-				version = l.Version
-			} else if rv, ok := tfmodule.RequiredProviders[r.Provider.Name]; ok && len(rv.VersionConstraints) > 0 {
-				version = strings.Join(rv.VersionConstraints, " ")
-			}
-
 			key := fmt.Sprintf("%s.%s", r.Provider.Name, r.Provider.Alias)
 			if existing, ok := discovered[key]; ok {
 				// keep the earliest position across all resources of this provider
@@ -411,15 +629,14 @@ func loadProviders(tfmodule *tfconfig.Module, config *print.Config) []*Provider
 				continue
 			}
 
-			discovered[key] = &Provider{
-				Name:    r.Provider.Name,
-				Alias:   types.String(r.Provider.Alias),
-				Version: types.String(version),
-				Position: Position{
-					Filename: r.Pos.Filename,
-					Line:     r.Pos.Line,
-				},
+			provider := loadProvider(tfmodule, r.Provider.Name, lock, host)
+			provider.Alias = types.String(r.Provider.Alias)
+			provider.Position = Position{
+				Filename: r.Pos.Filename,
+				Line:     r.Pos.Line,
 			}
+			provider.registry = resolver
+			discovered[key] = provider
 		}
 	}
 
@@ -437,12 +654,94 @@ func loadProviders(tfmodule *tfconfig.Module, config *print.Config) []*Provider
 	return providers
 }
 
+// loadProvider returns provider with local 'name', with its declared version
+// constraint and the version and checksums recorded in 'lock' file, if any.
+// 'host' is the implied host of its source.
+func loadProvider(tfmodule *tfconfig.Module, name string, lock map[string]lockedProvider, host string) *Provider {
+	source := providerSource(tfmodule, name)
+
+	// sources which aren't registry addresses are kept as is
+	address, ok := providerAddress(name, source, host)
+	if !ok {
+		address = source
+	}
+
+	var constraints []string
+	if rv, ok := tfmodule.RequiredProviders[name]; ok {
+		constraints = rv.VersionConstraints
+	}
+	constraint := strings.Join(constraints, ", ")
+
+	var locked string
+	hashes := make([]string, 0)
+	if l, ok := lock[strings.ToLower(address)]; ok {
+		locked = l.Version
+		hashes = append(hashes, l.Hashes...)
+
+		// constraints recorded in lock file are the ones of the whole
+		// configuration, only used if the module doesn't declare any
+		if constraint == "" && l.Constraints != nil {
+			constraint = *l.Constraints
+		}
+	}
+
+	version := locked
+	if version == "" {
+		version = strings.Join(constraints, " ")
+	}
+
+	return &Provider{
+		Name:       name,
+		Version:    types.String(version),
+		Source:     address,
+		Constraint: types.String(constraint),
+		Locked:     types.String(locked),
+		Hashes:     hashes,
+	}
+}
+
+// lockedProvider is a provider recorded in '.terraform.lock.hcl'.
+type lockedProvider struct {
+	Name        string   `hcl:"name,label"`
+	Version     string   `hcl:"version"`
+	Constraints *string  `hcl:"constraints"`
+	Hashes      []string `hcl:"hashes"`
+}
+
+// loadLockFile returns the providers recorded in '.terraform.lock.hcl' of the
+// module (with 'settings.lockfile'), keyed by their fully qualified address
+// in lower case, e.g. 'registry.terraform.io/hashicorp/aws'.
+func loadLockFile(config *print.Config) map[string]lockedProvider {
+	type lockfile struct {
+		Provider []lockedProvider `hcl:"provider,block"`
+	}
+	lock := make(map[string]lockedProvider)
+
+	if !config.Settings.LockFile {
+		return lock
+	}
+
+	var lf lockfile
+
+	filename := filepath.Join(config.ModuleRoot, ".terraform.lock.hcl")
+	if err := hclsimple.DecodeFile(filename, nil, &lf); err == nil {
+		for _, p := range lf.Provider {
+			lock[strings.ToLower(p.Name)] = p
+		}
+	}
+	return lock
+}
+
+// loadRequirements returns one requirement for Terraform and for each of the
+// providers declared in 'required_providers' block, with all of their version
+// constraints merged together.
 func loadRequirements(tfmodule *tfconfig.Module) []*Requirement {
 	var requirements = make([]*Requirement, 0)
-	for _, core := range tfmodule.RequiredCore {
+	if len(tfmodule.RequiredCore) > 0 {
 		requirements = append(requirements, &Requirement{
-			Name:    "terraform",
-			Version: types.String(core),
+			Name:                 "terraform",
+			Version:              types.String(strings.Join(tfmodule.RequiredCore, ", ")),
+			ConfigurationAliases: []string{},
 		})
 	}
 
@@ -454,17 +753,38 @@ func loadRequirements(tfmodule *tfconfig.Module) []*Requirement {
 	sort.Strings(names)
 
 	for _, name := range names {
-		for _, version := range tfmodule.RequiredProviders[name].VersionConstraints {
-			requirements = append(requirements, &Requirement{
-				Name:    name,
-				Version: types.String(version),
-			})
+		provider := tfmodule.RequiredProviders[name]
+
+		// providers only used by resources are implied, without any source,
+		// version constraint or configuration alias, and aren't requirements
+		if provider.Source == "" && len(provider.VersionConstraints) == 0 && len(provider.ConfigurationAliases) == 0 {
+			continue
+		}
+
+		aliases := make([]string, 0, len(provider.ConfigurationAliases))
+		for _, ref := range provider.ConfigurationAliases {
+			aliases = append(aliases, fmt.Sprintf("%s.%s", ref.Name, ref.Alias))
 		}
+
+		requirements = append(requirements, &Requirement{
+			Name:                 name,
+			Version:              types.String(strings.Join(provider.VersionConstraints, ", ")),
+			Source:               types.String(provider.Source),
+			ConfigurationAliases: aliases,
+		})
 	}
 	return requirements
 }
 
-func loadResources(tfmodule *tfconfig.Module, config *print.Config) []*Resource {
+func loadResources(tfmodule *tfconfig.Module, config *print.Config, files hclFiles) []*Resource {
+	return loadNestedResources(tfmodule, config, files, "")
+}
+
+// loadNestedResources returns the resources of 'tfmodule', whose address is
+// 'parent' (empty for the root module). Their address is only set with
+// 'settings.nested-resources'.
+func loadNestedResources(tfmodule *tfconfig.Module, config *print.Config, files hclFiles, parent string) []*Resource {
+	resolver := loadRegistry(config)
 	allResources := []map[string]*tfconfig.Resource{tfmodule.ManagedResources, tfmodule.DataResources}
 	discovered := make(map[string]*Resource)
 
@@ -482,13 +802,6 @@ func loadResources(tfmodule *tfconfig.Module, config *print.Config) []*Resource
 				version = resourceVersion(rv.VersionConstraints)
 			}
 
-			var source string
-			if len(tfmodule.RequiredProviders[r.Provider.Name].Source) > 0 {
-				source = tfmodule.RequiredProviders[r.Provider.Name].Source
-			} else {
-				source = fmt.Sprintf("%s/%s", "hashicorp", r.Provider.Name)
-			}
-
 			rType := strings.TrimPrefix(r.Type, r.Provider.Name+"_")
 			key := fmt.Sprintf("%s.%s.%s.%s", r.Provider.Name, r.Mode, rType, r.Name)
 
@@ -497,18 +810,32 @@ func loadResources(tfmodule *tfconfig.Module, config *print.Config) []*Resource
 				description = comments
 			}
 
+			blockType := "resource"
+			if r.Mode == tfconfig.DataResourceMode {
+				blockType = "data"
+			}
+			metaArgument := loadMetaArgument(files, r.Pos.Filename, r.Pos.Line, blockType)
+
+			var resourceAddress string
+			if config.Settings.NestedResources {
+				resourceAddress = address(parent, r.MapKey(), metaArgument)
+			}
+
 			discovered[key] = &Resource{
 				Type:           rType,
 				Name:           r.Name,
 				Mode:           r.Mode.String(),
 				ProviderName:   r.Provider.Name,
-				ProviderSource: source,
+				ProviderSource: providerSource(tfmodule, r.Provider.Name),
 				Version:        types.String(version),
 				Description:    types.String(description),
+				MetaArgument:   metaArgument,
+				Address:        resourceAddress,
 				Position: Position{
 					Filename: r.Pos.Filename,
 					Line:     r.Pos.Line,
 				},
+				registry: resolver,
 			}
 		}
 	}
@@ -526,6 +853,16 @@ func loadResources(tfmodule *tfconfig.Module, config *print.Config) []*Resource
 	return resources
 }
 
+// providerSource returns the source address of provider with local 'name' as
+// declared in 'required_providers', or the implied 'hashicorp/<name>' if it
+// isn't declared.
+func providerSource(tfmodule *tfconfig.Module, name string) string {
+	if rp, ok := tfmodule.RequiredProviders[name]; ok && rp.Source != "" {
+		return rp.Source
+	}
+	return fmt.Sprintf("%s/%s", "hashicorp", name)
+}
+
 func resourceVersion(constraints []string) string {
 	if len(constraints) == 0 {
 		return "latest"
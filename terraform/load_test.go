@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/exp/slices"
 
+	"github.com/terraform-docs/terraform-docs/internal/types"
 	"github.com/terraform-docs/terraform-docs/print"
 )
 
@@ -443,6 +444,105 @@ func TestLoadSections(t *testing.T) {
 			errText:  "--footer-from value is missing",
 			section:  "footer",
 		},
+		{
+			name:     "load module header from '#' line comments",
+			path:     "header-sources",
+			file:     "main.tf#comments",
+			expected: "# Main title\n\nHeader written with `#` line comments.\n\n    indented code block",
+			wantErr:  false,
+			errText:  "",
+			section:  "header",
+		},
+		{
+			name:     "load module header from '//' line comments",
+			path:     "header-sources",
+			file:     "versions.tf#comments",
+			expected: "Header written with `//` line comments.\n\n- list item 1\n- list item 2",
+			wantErr:  false,
+			errText:  "",
+			section:  "header",
+		},
+		{
+			name:     "no module header from line comments by default",
+			path:     "license-header",
+			file:     "main.tf",
+			expected: "",
+			wantErr:  false,
+			errText:  "",
+			section:  "header",
+		},
+		{
+			name:     "load module header without front matter",
+			path:     "header-sources",
+			file:     "intro.md",
+			expected: "# Introduction\n\nContent after the front matter.\n",
+			wantErr:  false,
+			errText:  "",
+			section:  "header",
+		},
+		{
+			name:     "load module header from named region",
+			path:     "header-sources",
+			file:     "README.md#header",
+			expected: "Content of the header region.\n\n- list item 1\n- list item 2",
+			wantErr:  false,
+			errText:  "",
+			section:  "header",
+		},
+		{
+			name:     "load module header from markdown heading",
+			path:     "header-sources",
+			file:     "README.md#getting-started",
+			expected: "Content of the getting started section.\n\n```sh\n# not a heading\nterraform init\n```\n\n### Requirements\n\nNested section is part of it.",
+			wantErr:  false,
+			errText:  "",
+			section:  "header",
+		},
+		{
+			name:     "load module footer from named region of any file",
+			path:     "header-sources",
+			file:     "footer.html#footer",
+			expected: "Content of the footer region.",
+			wantErr:  false,
+			errText:  "",
+			section:  "footer",
+		},
+		{
+			name:     "load module header from multiple sources",
+			path:     "header-sources",
+			file:     "versions.tf#comments, README.md#Usage, footer.html#footer",
+			expected: "Header written with `//` line comments.\n\n- list item 1\n- list item 2\n\nContent of the usage section.\n\nContent of the footer region.",
+			wantErr:  false,
+			errText:  "",
+			section:  "header",
+		},
+		{
+			name:     "error if region or heading is missing",
+			path:     "header-sources",
+			file:     "README.md#missing",
+			expected: "",
+			wantErr:  true,
+			errText:  "region or heading 'missing' not found in README.md to read header from",
+			section:  "header",
+		},
+		{
+			name:     "error if region end marker is missing",
+			path:     "header-sources",
+			file:     "README.md#unterminated",
+			expected: "",
+			wantErr:  true,
+			errText:  "'unterminated:end' marker is missing in README.md to read header from",
+			section:  "header",
+		},
+		{
+			name:     "error if any of multiple sources is missing",
+			path:     "header-sources",
+			file:     "main.tf,non-existent.md",
+			expected: "",
+			wantErr:  true,
+			errText:  "stat testdata/header-sources/non-existent.md: no such file or directory",
+			section:  "header",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -517,7 +617,7 @@ func TestLoadInputs(t *testing.T) {
 
 			config := print.NewConfig()
 			module, _ := loadModule(filepath.Join("testdata", tt.path))
-			inputs, requireds, optionals := loadInputs(module, config) // codespell:ignore requireds
+			inputs, requireds, optionals := loadInputs(module, config, newHCLFiles()) // codespell:ignore requireds
 
 			assert.Equal(tt.expected.inputs, len(inputs))
 			assert.Equal(tt.expected.requireds, len(requireds)) // codespell:ignore requireds
@@ -526,6 +626,190 @@ func TestLoadInputs(t *testing.T) {
 	}
 }
 
+func TestLoadInputsValidations(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []*Validation
+	}{
+		{
+			name:  "load input validations",
+			input: "name",
+			expected: []*Validation{
+				{
+					Condition:    "length(var.name) > 3",
+					ErrorMessage: "The name must be longer than 3 characters.",
+				},
+				{
+					Condition:    `can(regex("^[a-z]+$", var.name))`,
+					ErrorMessage: `The name ${var.name} must be lowercase.`,
+				},
+			},
+		},
+		{
+			name:     "load input without validations",
+			input:    "size",
+			expected: []*Validation{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			module, _ := loadModule(filepath.Join("testdata", "input-validations"))
+			inputs, _, _ := loadInputs(module, config, newHCLFiles())
+
+			var input *Input
+			for _, i := range inputs {
+				if i.Name == tt.input {
+					input = i
+				}
+			}
+
+			assert.NotNil(input)
+			assert.Equal(tt.expected, input.Validations)
+			assert.Equal(len(tt.expected) > 0, input.HasValidations())
+		})
+	}
+}
+
+func TestLoadInputsAttributes(t *testing.T) {
+	type expected struct {
+		sensitive bool
+		nullable  bool
+		ephemeral bool
+	}
+	tests := []struct {
+		name     string
+		input    string
+		expected expected
+	}{
+		{
+			name:  "load sensitive and non-nullable input",
+			input: "password",
+			expected: expected{
+				sensitive: true,
+				nullable:  false,
+				ephemeral: false,
+			},
+		},
+		{
+			name:  "load ephemeral input",
+			input: "session_token",
+			expected: expected{
+				sensitive: false,
+				nullable:  true,
+				ephemeral: true,
+			},
+		},
+		{
+			name:  "load input with default attributes",
+			input: "tags",
+			expected: expected{
+				sensitive: false,
+				nullable:  true,
+				ephemeral: false,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			module, _ := loadModule(filepath.Join("testdata", "input-attributes"))
+			inputs, _, _ := loadInputs(module, config, newHCLFiles())
+
+			var input *Input
+			for _, i := range inputs {
+				if i.Name == tt.input {
+					input = i
+				}
+			}
+
+			assert.NotNil(input)
+			assert.Equal(tt.expected.sensitive, input.Sensitive)
+			assert.Equal(tt.expected.nullable, input.Nullable)
+			assert.Equal(tt.expected.ephemeral, input.Ephemeral)
+		})
+	}
+}
+
+func TestLoadInputExamples(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  bool
+		from     []string
+		expected map[string]interface{}
+		wantErr  bool
+	}{
+		{
+			name:     "load input examples disabled",
+			enabled:  false,
+			from:     []string{},
+			expected: map[string]interface{}{},
+			wantErr:  false,
+		},
+		{
+			name:    "load input examples from terraform.tfvars except sensitive inputs",
+			enabled: true,
+			from:    []string{},
+			expected: map[string]interface{}{
+				"name": "example",
+				"tags": map[string]interface{}{"Environment": "dev"},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "load input examples from files and directories",
+			enabled: true,
+			from:    []string{"terraform.tfvars", "examples"},
+			expected: map[string]interface{}{
+				"name":  "example",
+				"tags":  map[string]interface{}{"Environment": "prod", "Team": "platform"},
+				"zones": []interface{}{"eu-west-1a", "eu-west-1b"},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "load input examples from missing file",
+			enabled:  true,
+			from:     []string{"no-file.tfvars"},
+			expected: map[string]interface{}{},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", "example-values")
+			config.ExampleValues.Enabled = tt.enabled
+			config.ExampleValues.From = tt.from
+
+			module, _ := loadModule(config.ModuleRoot)
+			inputs, _, _ := loadInputs(module, config, newHCLFiles())
+			err := loadInputExamples(inputs, config)
+
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+
+				actual := map[string]interface{}{}
+				for _, input := range inputs {
+					if input.HasExample() {
+						actual[input.Name] = input.Example.Raw()
+					}
+				}
+				assert.Equal(tt.expected, actual)
+			}
+		})
+	}
+}
+
 func TestLoadModulecalls(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -549,13 +833,115 @@ func TestLoadModulecalls(t *testing.T) {
 
 			config := print.NewConfig()
 			module, _ := loadModule(filepath.Join("testdata", tt.path))
-			modulecalls := loadModulecalls(module, config)
+			modulecalls := loadModulecalls(module, config, newHCLFiles())
 
 			assert.Equal(tt.expected, len(modulecalls))
 		})
 	}
 }
 
+func TestLoadChecks(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		expected []*Check
+	}{
+		{
+			name: "load checks from path",
+			path: "checks",
+			expected: []*Check{
+				{Type: "precondition", Owner: "null_resource.main", Condition: "length(var.name) > 0", ErrorMessage: "The name must not be empty.", File: "main.tf", Line: 7},
+				{Type: "postcondition", Owner: "null_resource.main", Condition: `self.id != ""`, ErrorMessage: "The resource must have an ID.", File: "main.tf", Line: 12},
+				{Type: "postcondition", Owner: "data.http.health", Condition: "contains([200, 204], self.status_code)", ErrorMessage: "The service must be healthy.", File: "main.tf", Line: 23},
+				{Type: "assert", Owner: "check.health", Condition: "data.http.status.status_code == 200 || data.http.status.status_code == 204", ErrorMessage: "${data.http.status.url} returned an unhealthy status code.", File: "main.tf", Line: 45},
+				{Type: "precondition", Owner: "output.id", Condition: "null_resource.main.id != null", ErrorMessage: "The resource must be created first.", File: "outputs.tf", Line: 5},
+			},
+		},
+		{
+			name:     "load checks from path",
+			path:     "full-example",
+			expected: []*Check{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", tt.path)
+			checks := loadChecks(config, newHCLFiles())
+
+			assert.Equal(tt.expected, checks)
+		})
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		expected []*Migration
+	}{
+		{
+			name: "load migrations from path",
+			path: "migrations",
+			expected: []*Migration{
+				{Type: "import", To: "null_resource.bar", ID: "bar-id", File: "imports.tf", Line: 1},
+				{Type: "import", To: "null_resource.qux", ID: "var.qux_id", File: "imports.tf", Line: 6},
+				{Type: "moved", From: "null_resource.foo", To: "null_resource.bar", Description: "Renamed in v2.0.0", File: "main.tf", Line: 4},
+				{Type: "moved", From: `module.old["a"]`, To: `module.new["a"]`, File: "main.tf", Line: 9},
+				{Type: "removed", From: "null_resource.baz", File: "main.tf", Line: 14},
+			},
+		},
+		{
+			name:     "load migrations from path",
+			path:     "full-example",
+			expected: []*Migration{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", tt.path)
+			config.Settings.ReadComments = true
+			migrations := loadMigrations(config, newHCLFiles())
+
+			assert.Equal(tt.expected, migrations)
+		})
+	}
+}
+
+func TestLoadInputsParseOnce(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.NewConfig()
+	config.ModuleRoot = filepath.Join("testdata", "input-types")
+	module, _ := loadModule(config.ModuleRoot)
+
+	files := newHCLFiles()
+	loadInputs(module, config, files)
+	assert.Len(files, 1)
+
+	filename := filepath.Join(config.ModuleRoot, "variables.tf")
+	file := files[filename]
+	assert.NotNil(file)
+	assert.NotNil(file.body)
+	assert.NotNil(file.comments)
+
+	loadInputs(module, config, files)
+	assert.Len(files, 1)
+	assert.Same(file, files[filename])
+
+	blocks := files.blocks(filename, "variable")
+	assert.NotEmpty(blocks)
+	for _, b := range blocks {
+		assert.Same(file, b.file)
+		assert.Same(file.comments, b.comments())
+	}
+}
+
 func TestLoadInputsLineEnding(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -579,7 +965,7 @@ func TestLoadInputsLineEnding(t *testing.T) {
 
 			config := print.NewConfig()
 			module, _ := loadModule(filepath.Join("testdata", tt.path))
-			inputs, _, _ := loadInputs(module, config)
+			inputs, _, _ := loadInputs(module, config, newHCLFiles())
 
 			assert.Equal(1, len(inputs))
 			assert.Equal(tt.expected, string(inputs[0].Description))
@@ -785,6 +1171,92 @@ func TestLoadProviders(t *testing.T) {
 	}
 }
 
+func TestLoadProvidersLockFile(t *testing.T) {
+	tests := map[string]struct {
+		lockfile bool
+		expected []*Provider
+	}{
+		"WithLockFile": {
+			lockfile: true,
+			expected: []*Provider{
+				{
+					Name:       "acme",
+					Version:    types.String("1.2.0"),
+					Source:     "registry.acme.com/acme/aws",
+					Constraint: types.String("~> 1.2"),
+					Locked:     types.String("1.2.0"),
+					Hashes:     []string{"h1:4Bn8aHf3bvTymHv7D1GMmV7cB7kYJ5oB1Xr4o9H6yfs="},
+				},
+				{
+					Name:       "aws",
+					Version:    types.String("5.31.0"),
+					Source:     "registry.terraform.io/hashicorp/aws",
+					Constraint: types.String(">= 4.0, < 6.0"),
+					Locked:     types.String("5.31.0"),
+					Hashes: []string{
+						"h1:ltxyuBWIy9cq0kIKDJH1jeWJy/y7XJLjS4QrsQK4plA=",
+						"zh:0cdb9c2083bf0902442384f7309367791e4640581652dda456f2d6d7abf0de8d",
+					},
+				},
+				{
+					Name:       "random",
+					Version:    types.String(""),
+					Source:     "registry.terraform.io/hashicorp/random",
+					Constraint: types.String(""),
+					Locked:     types.String(""),
+					Hashes:     []string{},
+				},
+			},
+		},
+		"WithoutLockFile": {
+			lockfile: false,
+			expected: []*Provider{
+				{
+					Name:       "acme",
+					Version:    types.String(""),
+					Source:     "registry.acme.com/acme/aws",
+					Constraint: types.String(""),
+					Locked:     types.String(""),
+					Hashes:     []string{},
+				},
+				{
+					Name:       "aws",
+					Version:    types.String(">= 4.0, < 6.0"),
+					Source:     "registry.terraform.io/hashicorp/aws",
+					Constraint: types.String(">= 4.0, < 6.0"),
+					Locked:     types.String(""),
+					Hashes:     []string{},
+				},
+				{
+					Name:       "random",
+					Version:    types.String(""),
+					Source:     "registry.terraform.io/hashicorp/random",
+					Constraint: types.String(""),
+					Locked:     types.String(""),
+					Hashes:     []string{},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			config.ModuleRoot = filepath.Join("testdata", "lock-file")
+			config.Settings.LockFile = tt.lockfile
+
+			module, _ := loadModule(config.ModuleRoot)
+			providers := loadProviders(module, config)
+
+			for _, p := range providers {
+				p.Position = Position{}
+			}
+			assert.Equal(tt.expected, providers)
+		})
+	}
+}
+
 func TestLoadRequirements(t *testing.T) {
 	type expected struct {
 		requirements []string
@@ -808,6 +1280,13 @@ func TestLoadRequirements(t *testing.T) {
 				requirements: []string{},
 			},
 		},
+		{
+			name: "load module requirements with merged constraints",
+			path: "requirements",
+			expected: expected{
+				requirements: []string{"terraform >= 1.3, < 2.0", "aws >= 4.0, < 6.0", "random "},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -825,6 +1304,35 @@ func TestLoadRequirements(t *testing.T) {
 	}
 }
 
+func TestLoadRequirementsSources(t *testing.T) {
+	assert := assert.New(t)
+
+	module, _ := loadModule(filepath.Join("testdata", "requirements"))
+	requirements := loadRequirements(module)
+
+	expected := []*Requirement{
+		{
+			Name:                 "terraform",
+			Version:              types.String(">= 1.3, < 2.0"),
+			Source:               types.String(""),
+			ConfigurationAliases: []string{},
+		},
+		{
+			Name:                 "aws",
+			Version:              types.String(">= 4.0, < 6.0"),
+			Source:               types.String("hashicorp/aws"),
+			ConfigurationAliases: []string{"aws.east", "aws.west"},
+		},
+		{
+			Name:                 "random",
+			Version:              types.String(""),
+			Source:               types.String("hashicorp/random"),
+			ConfigurationAliases: []string{},
+		},
+	}
+	assert.Equal(expected, requirements)
+}
+
 func TestLoadResources(t *testing.T) {
 	type expected struct {
 		resources []string
@@ -855,7 +1363,7 @@ func TestLoadResources(t *testing.T) {
 
 			config := print.NewConfig()
 			module, _ := loadModule(filepath.Join("testdata", tt.path))
-			resources := loadResources(module, config)
+			resources := loadResources(module, config, newHCLFiles())
 
 			assert.Equal(len(tt.expected.resources), len(resources))
 
@@ -941,7 +1449,7 @@ func TestLoadResourcesDeterministic(t *testing.T) {
 			assert.Nil(err)
 
 			for i := 0; i < 100; i++ {
-				rr := loadResources(module, config)
+				rr := loadResources(module, config, newHCLFiles())
 
 				actual := make([]string, len(rr))
 				for j, r := range rr {
@@ -953,6 +1461,75 @@ func TestLoadResourcesDeterministic(t *testing.T) {
 	}
 }
 
+func TestLoadResourcesMetaArgument(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.NewConfig()
+	config.ModuleRoot = filepath.Join("testdata", "nested-resources")
+	module, err := loadModule(config.ModuleRoot)
+	assert.Nil(err)
+
+	actual := make(map[string]string)
+	for _, r := range loadResources(module, config, newHCLFiles()) {
+		actual[r.Spec()] = r.MetaArgument
+		assert.Empty(r.Address)
+	}
+	assert.Equal(map[string]string{"aws_s3_bucket.logs": "count", "aws_caller_identity.current": ""}, actual)
+
+	for _, m := range loadModulecalls(module, config, newHCLFiles()) {
+		assert.Empty(m.Address)
+		assert.Empty(m.Resources)
+		assert.Empty(m.ModuleCalls)
+	}
+}
+
+func TestLoadModulecallsNested(t *testing.T) {
+	assert := assert.New(t)
+
+	config := print.NewConfig()
+	config.ModuleRoot = filepath.Join("testdata", "nested-resources")
+	config.Settings.NestedResources = true
+
+	module, err := LoadWithOptions(config)
+	assert.Nil(err)
+
+	addresses := func(rr []*Resource) []string {
+		items := make([]string, 0, len(rr))
+		for _, r := range rr {
+			items = append(items, r.Address)
+		}
+		return items
+	}
+
+	assert.Equal([]string{"aws_s3_bucket.logs[*]", "data.aws_caller_identity.current"}, addresses(module.Resources))
+	assert.Equal(2, len(module.ModuleCalls))
+
+	network := module.ModuleCalls[0]
+	assert.Equal("module.network[*]", network.Address)
+	assert.Equal("for_each", network.MetaArgument)
+	assert.Equal([]string{
+		"module.network[*].aws_subnet.this[*]",
+		"module.network[*].aws_vpc.this",
+		"module.network[*].data.aws_availability_zones.available",
+	}, addresses(network.Resources))
+	assert.Equal(2, len(network.ModuleCalls))
+
+	// cyclic reference isn't followed
+	root := network.ModuleCalls[1]
+	assert.Equal("module.network[*].module.root", root.Address)
+	assert.Empty(root.Resources)
+	assert.Empty(root.ModuleCalls)
+
+	security := network.ModuleCalls[0]
+	assert.Equal("module.network[*].module.security[*]", security.Address)
+	assert.Equal([]string{"module.network[*].module.security[*].aws_security_group.this"}, addresses(security.Resources))
+
+	// registry modules aren't loaded
+	vpc := module.ModuleCalls[1]
+	assert.Empty(vpc.Address)
+	assert.Empty(vpc.Resources)
+}
+
 func TestLoadComments(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -1040,7 +1617,7 @@ func TestReadComments(t *testing.T) {
 
 			assert.Nil(err)
 
-			inputs, _, _ := loadInputs(module, config)
+			inputs, _, _ := loadInputs(module, config, newHCLFiles())
 			assert.Equal(1, len(inputs))
 			assert.Equal(tt.expected, string(inputs[0].Description))
 
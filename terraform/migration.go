@@ -0,0 +1,43 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"fmt"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// Migration types.
+const (
+	MigrationMoved   = "moved"
+	MigrationRemoved = "removed"
+	MigrationImport  = "import"
+)
+
+// Migration represents a refactoring of the module declared with 'moved',
+// 'removed' or 'import' blocks, which consumers of the module need to know
+// about when upgrading between its versions.
+type Migration struct {
+	Type        string       `json:"type" toml:"type" xml:"type" yaml:"type"`
+	From        string       `json:"from" toml:"from" xml:"from" yaml:"from"`
+	To          string       `json:"to" toml:"to" xml:"to" yaml:"to"`
+	ID          string       `json:"id" toml:"id" xml:"id" yaml:"id"`
+	Description types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
+	File        string       `json:"file" toml:"file" xml:"file" yaml:"file"`
+	Line        int          `json:"line" toml:"line" xml:"line" yaml:"line"`
+}
+
+// Location returns the file and line the migration is declared at, in the
+// form of 'file:line'.
+func (m *Migration) Location() string {
+	return fmt.Sprintf("%s:%d", m.File, m.Line)
+}
@@ -20,7 +20,9 @@ type Module struct {
 
 	Header       string         `json:"header" toml:"header" xml:"header" yaml:"header"`
 	Footer       string         `json:"footer" toml:"footer" xml:"footer" yaml:"footer"`
+	Checks       []*Check       `json:"checks" toml:"checks" xml:"checks>check" yaml:"checks"`
 	Inputs       []*Input       `json:"inputs" toml:"inputs" xml:"inputs>input" yaml:"inputs"`
+	Migrations   []*Migration   `json:"migrations" toml:"migrations" xml:"migrations>migration" yaml:"migrations"`
 	ModuleCalls  []*ModuleCall  `json:"modules" toml:"modules" xml:"modules>module" yaml:"modules"`
 	Outputs      []*Output      `json:"outputs" toml:"outputs" xml:"outputs>output" yaml:"outputs"`
 	Providers    []*Provider    `json:"providers" toml:"providers" xml:"providers>provider" yaml:"providers"`
@@ -41,11 +43,82 @@ func (m *Module) HasFooter() bool {
 	return len(m.Footer) > 0
 }
 
+// HasChecks indicates if the module has checks.
+func (m *Module) HasChecks() bool {
+	return len(m.Checks) > 0
+}
+
 // HasInputs indicates if the module has inputs.
 func (m *Module) HasInputs() bool {
 	return len(m.Inputs) > 0
 }
 
+// HasInputAttributeDescriptions indicates if any of the module inputs has
+// documented object attributes.
+func (m *Module) HasInputAttributeDescriptions() bool {
+	for _, i := range m.Inputs {
+		if i.HasAttributeDescriptions() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasInputValidations indicates if any of the module inputs has validation rules.
+func (m *Module) HasInputValidations() bool {
+	for _, i := range m.Inputs {
+		if i.HasValidations() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasInputExamples indicates if any of the module inputs has an example value.
+func (m *Module) HasInputExamples() bool {
+	for _, i := range m.Inputs {
+		if i.HasExample() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasSensitiveInputs indicates if any of the module inputs is sensitive.
+func (m *Module) HasSensitiveInputs() bool {
+	for _, i := range m.Inputs {
+		if i.Sensitive {
+			return true
+		}
+	}
+	return false
+}
+
+// HasNonNullableInputs indicates if any of the module inputs is not nullable.
+func (m *Module) HasNonNullableInputs() bool {
+	for _, i := range m.Inputs {
+		if !i.Nullable {
+			return true
+		}
+	}
+	return false
+}
+
+// HasEphemeralInputs indicates if any of the module inputs is ephemeral.
+func (m *Module) HasEphemeralInputs() bool {
+	for _, i := range m.Inputs {
+		if i.Ephemeral {
+			return true
+		}
+	}
+	return false
+}
+
+// HasMigrations indicates if the module has migrations.
+func (m *Module) HasMigrations() bool {
+	return len(m.Migrations) > 0
+}
+
 // HasModuleCalls indicates if the module has modulecalls.
 func (m *Module) HasModuleCalls() bool {
 	return len(m.ModuleCalls) > 0
@@ -61,11 +134,44 @@ func (m *Module) HasProviders() bool {
 	return len(m.Providers) > 0
 }
 
+// HasLockedProviders indicates if any of the providers of the module has its
+// version recorded in the lock file.
+func (m *Module) HasLockedProviders() bool {
+	for _, p := range m.Providers {
+		if p.Locked != "" {
+			return true
+		}
+	}
+	return false
+}
+
 // HasRequirements indicates if the module has requirements.
 func (m *Module) HasRequirements() bool {
 	return len(m.Requirements) > 0
 }
 
+// HasRequirementSources indicates if any of the requirements of the module
+// is declared with a source address.
+func (m *Module) HasRequirementSources() bool {
+	for _, r := range m.Requirements {
+		if r.Source != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasConfigurationAliases indicates if any of the requirements of the module
+// is declared with configuration aliases.
+func (m *Module) HasConfigurationAliases() bool {
+	for _, r := range m.Requirements {
+		if len(r.ConfigurationAliases) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // HasResources indicates if the module has resources.
 func (m *Module) HasResources() bool {
 	return len(m.Resources) > 0
@@ -24,7 +24,16 @@ type ModuleCall struct {
 	Source      string       `json:"source" toml:"source" xml:"source" yaml:"source"`
 	Version     string       `json:"version" toml:"version" xml:"version" yaml:"version"`
 	Description types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
-	Position    Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
+
+	// MetaArgument is either 'count' or 'for_each' if the module is repeated
+	// by any of them, and Address, Resources and ModuleCalls are only set for
+	// local modules (e.g. './modules/network') with 'settings.nested-resources'.
+	MetaArgument string        `json:"meta_argument,omitempty" toml:"meta_argument,omitempty" xml:"meta_argument,omitempty" yaml:"meta_argument,omitempty"`
+	Address      string        `json:"address,omitempty" toml:"address,omitempty" xml:"address,omitempty" yaml:"address,omitempty"`
+	Resources    []*Resource   `json:"resources,omitempty" toml:"resources,omitempty" xml:"resource,omitempty" yaml:"resources,omitempty"`
+	ModuleCalls  []*ModuleCall `json:"modules,omitempty" toml:"modules,omitempty" xml:"module,omitempty" yaml:"modules,omitempty"`
+
+	Position Position `json:"-" toml:"-" xml:"-" yaml:"-"`
 }
 
 // FullName returns full name of the modulecall, with version if available
@@ -17,12 +17,24 @@ import (
 	"github.com/terraform-docs/terraform-docs/internal/types"
 )
 
-// Provider represents a Terraform output.
+// Provider represents a Terraform provider.
 type Provider struct {
-	Name     string       `json:"name" toml:"name" xml:"name" yaml:"name"`
-	Alias    types.String `json:"alias" toml:"alias" xml:"alias" yaml:"alias"`
-	Version  types.String `json:"version" toml:"version" xml:"version" yaml:"version"`
-	Position Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
+	Name    string       `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Alias   types.String `json:"alias" toml:"alias" xml:"alias" yaml:"alias"`
+	Version types.String `json:"version" toml:"version" xml:"version" yaml:"version"`
+
+	// Source is the fully qualified address of the provider, Constraint is
+	// its declared version constraint, and Locked and Hashes are its version
+	// and checksums recorded in '.terraform.lock.hcl' (with 'settings.lockfile').
+	// Version is the locked version, if any, or the declared constraint.
+	Source     string       `json:"source" toml:"source" xml:"source" yaml:"source"`
+	Constraint types.String `json:"constraint" toml:"constraint" xml:"constraint" yaml:"constraint"`
+	Locked     types.String `json:"locked" toml:"locked" xml:"locked" yaml:"locked"`
+	Hashes     []string     `json:"hashes" toml:"hashes" xml:"hashes>hash" yaml:"hashes"`
+
+	Position Position `json:"-" toml:"-" xml:"-" yaml:"-"`
+
+	registry *registry
 }
 
 // FullName returns full name of the provider, with alias if available
@@ -33,6 +45,20 @@ func (p *Provider) FullName() string {
 	return p.Name
 }
 
+// URL returns the URL of the provider documentation, based on its source and
+// 'registry' config. It is empty if the URL can't be resolved.
+func (p *Provider) URL() string {
+	resolver := p.registry
+	if resolver == nil {
+		resolver = defaultRegistry
+	}
+	var constraints []string
+	if p.Version != "" {
+		constraints = append(constraints, string(p.Version))
+	}
+	return resolver.providerURL(p.Name, p.Source, resourceVersion(constraints))
+}
+
 func sortProvidersByName(x []*Provider) {
 	sort.Slice(x, func(i, j int) bool {
 		if x[i].Name == x[j].Name {
@@ -50,6 +50,50 @@ func TestProviderName(t *testing.T) {
 	}
 }
 
+func TestProviderURL(t *testing.T) {
+	tests := map[string]struct {
+		provider Provider
+		expected string
+	}{
+		"ImpliedSource": {
+			provider: Provider{
+				Name:    "aws",
+				Version: types.String(">= 2.15.0"),
+			},
+			expected: "https://registry.terraform.io/providers/hashicorp/aws/latest",
+		},
+		"ExactVersion": {
+			provider: Provider{
+				Name:    "tls",
+				Version: types.String("4.0.4"),
+				Source:  "hashicorp/tls",
+			},
+			expected: "https://registry.terraform.io/providers/hashicorp/tls/4.0.4",
+		},
+		"OpenTofuRegistry": {
+			provider: Provider{
+				Name:    "random",
+				Version: types.String("= 3.6.0"),
+				Source:  "registry.opentofu.org/opentofu/random",
+			},
+			expected: "https://search.opentofu.org/provider/opentofu/random/3.6.0",
+		},
+		"UnknownHost": {
+			provider: Provider{
+				Name:   "foo",
+				Source: "registry.acme.com/acme/foo",
+			},
+			expected: "",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, tt.provider.URL())
+		})
+	}
+}
+
 func TestProvidersSort(t *testing.T) {
 	providers := sampleProviders()
 	tests := map[string]struct {
@@ -0,0 +1,214 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"bytes"
+	"strings"
+	gotemplate "text/template"
+
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+// RegistryLink is the data available to the URL templates of 'registry'
+// config, of a provider or a resource of it.
+type RegistryLink struct {
+	Host      string // e.g. registry.terraform.io
+	Namespace string // e.g. hashicorp
+	Name      string // e.g. aws
+	Version   string // exact version, or 'latest'
+	Kind      string // 'resources' or 'data-sources', empty for providers
+	Type      string // type of resource without provider prefix, e.g. instance
+}
+
+// urlTemplates are the templates of documentation URLs of a provider and of
+// its resources, an empty template means no URL.
+type urlTemplates struct {
+	provider string
+	resource string
+}
+
+// defaultRegistryHosts are the templates of the public registries.
+var defaultRegistryHosts = map[string]urlTemplates{
+	"registry.terraform.io": {
+		provider: "https://registry.terraform.io/providers/{{ .Namespace }}/{{ .Name }}/{{ .Version }}",
+		resource: "https://registry.terraform.io/providers/{{ .Namespace }}/{{ .Name }}/{{ .Version }}/docs/{{ .Kind }}/{{ .Type }}",
+	},
+	"registry.opentofu.org": {
+		provider: "https://search.opentofu.org/provider/{{ .Namespace }}/{{ .Name }}/{{ .Version }}",
+		resource: "https://search.opentofu.org/provider/{{ .Namespace }}/{{ .Name }}/{{ .Version }}/docs/{{ .Kind }}/{{ .Type }}",
+	},
+}
+
+// registry resolves documentation URLs of providers and resources, based on
+// their source address (i.e. [host/]namespace/name) and 'registry' config.
+type registry struct {
+	host      string
+	hosts     map[string]urlTemplates
+	providers map[string]urlTemplates
+}
+
+// defaultRegistry resolves URLs of items which aren't loaded from a module,
+// with the public registries only.
+var defaultRegistry = newRegistry(print.NewConfig())
+
+// loadRegistry returns the resolver of 'registry' config, or nil if it only
+// resolves with the public registries, i.e. as 'defaultRegistry' does.
+func loadRegistry(config *print.Config) *registry {
+	if len(config.Registry.Hosts) == 0 && len(config.Registry.Providers) == 0 &&
+		(config.Registry.Host == "" || config.Registry.Host == print.DefaultRegistryHost) {
+		return nil
+	}
+	return newRegistry(config)
+}
+
+// AttachRegistry resolves the documentation URLs of providers and resources
+// of 'module' based on 'registry' config. Modules which are rebuilt outside
+// of this package, e.g. returned by enricher plugins, only resolve them with
+// the public registries otherwise.
+func AttachRegistry(module *Module, config *print.Config) {
+	resolver := loadRegistry(config)
+	for _, p := range module.Providers {
+		p.registry = resolver
+	}
+	attachResourcesRegistry(module.Resources, module.ModuleCalls, resolver)
+}
+
+func attachResourcesRegistry(resources []*Resource, modulecalls []*ModuleCall, resolver *registry) {
+	for _, r := range resources {
+		r.registry = resolver
+	}
+	for _, mc := range modulecalls {
+		attachResourcesRegistry(mc.Resources, mc.ModuleCalls, resolver)
+	}
+}
+
+func newRegistry(config *print.Config) *registry {
+	r := &registry{
+		host:      config.Registry.Host,
+		hosts:     make(map[string]urlTemplates),
+		providers: make(map[string]urlTemplates),
+	}
+	if r.host == "" {
+		r.host = print.DefaultRegistryHost
+	}
+	for host, templates := range defaultRegistryHosts {
+		r.hosts[host] = templates
+	}
+	for _, h := range config.Registry.Hosts {
+		r.hosts[h.Host] = urlTemplates{provider: h.Provider, resource: h.Resource}
+	}
+	for _, p := range config.Registry.Providers {
+		r.providers[p.Name] = urlTemplates{provider: p.Provider, resource: p.Resource}
+	}
+	return r
+}
+
+// source returns the source address of provider with local 'name' declared
+// with 'source' (if any), with the implied host and namespace of Terraform
+// filled in, i.e. 'hashicorp/<name>' if the source isn't declared.
+func (r *registry) source(name string, source string) (string, bool) {
+	return providerAddress(name, source, r.host)
+}
+
+// providerAddress returns the fully qualified address of provider with local
+// 'name' declared with 'source' (if any), i.e. '<host>/<namespace>/<name>',
+// where 'host' is the implied host of sources which don't have any.
+func providerAddress(name string, source string, host string) (string, bool) {
+	if source == "" {
+		source = "hashicorp/" + name
+	}
+	segments := strings.Split(source, "/")
+	switch len(segments) {
+	case 2:
+		segments = append([]string{host}, segments...)
+	case 3:
+	default:
+		return "", false
+	}
+	for _, s := range segments {
+		if s == "" || strings.Contains(s, ":") {
+			return "", false
+		}
+	}
+	segments[0] = strings.ToLower(segments[0])
+	return strings.Join(segments, "/"), true
+}
+
+// link returns the data of URL templates of provider with local 'name'.
+func (r *registry) link(name string, source string, version string) (*RegistryLink, bool) {
+	address, ok := r.source(name, source)
+	if !ok {
+		return nil, false
+	}
+	segments := strings.Split(address, "/")
+	if version == "" {
+		version = "latest"
+	}
+	return &RegistryLink{
+		Host:      segments[0],
+		Namespace: segments[1],
+		Name:      segments[2],
+		Version:   version,
+	}, true
+}
+
+// templates returns the URL templates of provider with local 'name', which
+// are either overridden for the provider, or the ones of its host.
+func (r *registry) templates(name string, host string) urlTemplates {
+	if templates, ok := r.providers[name]; ok {
+		return templates
+	}
+	return r.hosts[host]
+}
+
+// providerURL returns the documentation URL of provider with local 'name'.
+func (r *registry) providerURL(name string, source string, version string) string {
+	link, ok := r.link(name, source, version)
+	if !ok {
+		return ""
+	}
+	return render(r.templates(name, link.Host).provider, link)
+}
+
+// resourceURL returns the documentation URL of resource 'resourceType' (of
+// 'mode' managed or data) of provider with local 'name'.
+func (r *registry) resourceURL(name string, source string, version string, mode string, resourceType string) string {
+	link, ok := r.link(name, source, version)
+	if !ok {
+		return ""
+	}
+	switch mode {
+	case "managed":
+		link.Kind = "resources"
+	case "data":
+		link.Kind = "data-sources"
+	default:
+		return ""
+	}
+	link.Type = resourceType
+	return render(r.templates(name, link.Host).resource, link)
+}
+
+func render(text string, link *RegistryLink) string {
+	if text == "" {
+		return ""
+	}
+	tmpl, err := gotemplate.New("url").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return ""
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, link); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(buf.String())
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+func TestRegistryURL(t *testing.T) {
+	config := readRegistryConfig(t, `
+registry:
+  hosts:
+    - host: registry.acme.com
+      provider: "https://docs.acme.com/{{ .Namespace }}/{{ .Name }}"
+      resource: "https://docs.acme.com/{{ .Namespace }}/{{ .Name }}/{{ .Version }}/{{ .Kind }}/{{ .Type }}"
+    - host: registry.terraform.io
+      provider: "https://mirror.acme.com/{{ .Namespace }}/{{ .Name }}"
+      resource: "https://mirror.acme.com/{{ .Namespace }}/{{ .Name }}/{{ .Type }}"
+  providers:
+    - name: internal
+      provider: "https://wiki.acme.com/providers/{{ .Name }}"
+      resource: ""
+    - name: broken
+      resource: "https://wiki.acme.com/{{ .Foo }}"
+`)
+	resolver := newRegistry(config)
+
+	tests := map[string]struct {
+		name     string
+		source   string
+		version  string
+		mode     string
+		rtype    string
+		provider string
+		resource string
+	}{
+		"ImpliedSource": {
+			name:     "aws",
+			source:   "",
+			version:  "latest",
+			mode:     "managed",
+			rtype:    "instance",
+			provider: "https://mirror.acme.com/hashicorp/aws",
+			resource: "https://mirror.acme.com/hashicorp/aws/instance",
+		},
+		"PrivateRegistry": {
+			name:     "foo",
+			source:   "Registry.Acme.com/acme/foo",
+			version:  "1.2.3",
+			mode:     "data",
+			rtype:    "bar",
+			provider: "https://docs.acme.com/acme/foo",
+			resource: "https://docs.acme.com/acme/foo/1.2.3/data-sources/bar",
+		},
+		"PublicRegistry": {
+			name:     "random",
+			source:   "registry.opentofu.org/opentofu/random",
+			version:  "",
+			mode:     "managed",
+			rtype:    "password",
+			provider: "https://search.opentofu.org/provider/opentofu/random/latest",
+			resource: "https://search.opentofu.org/provider/opentofu/random/latest/docs/resources/password",
+		},
+		"ProviderOverride": {
+			name:     "internal",
+			source:   "acme/internal",
+			version:  "latest",
+			mode:     "managed",
+			rtype:    "thing",
+			provider: "https://wiki.acme.com/providers/internal",
+			resource: "",
+		},
+		"ProviderOverrideInvalid": {
+			name:     "broken",
+			source:   "acme/broken",
+			version:  "latest",
+			mode:     "managed",
+			rtype:    "thing",
+			provider: "",
+			resource: "",
+		},
+		"UnknownHost": {
+			name:     "foo",
+			source:   "example.com/acme/foo",
+			version:  "latest",
+			mode:     "managed",
+			rtype:    "bar",
+			provider: "",
+			resource: "",
+		},
+		"InvalidSource": {
+			name:     "foo",
+			source:   "http://nih.tld/some/path/to/provider/source",
+			version:  "latest",
+			mode:     "managed",
+			rtype:    "bar",
+			provider: "",
+			resource: "",
+		},
+		"InvalidMode": {
+			name:     "aws",
+			source:   "hashicorp/aws",
+			version:  "latest",
+			mode:     "foo",
+			rtype:    "instance",
+			provider: "https://mirror.acme.com/hashicorp/aws",
+			resource: "",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(tt.provider, resolver.providerURL(tt.name, tt.source, tt.version))
+			assert.Equal(tt.resource, resolver.resourceURL(tt.name, tt.source, tt.version, tt.mode, tt.rtype))
+		})
+	}
+}
+
+func TestRegistryDefaultHost(t *testing.T) {
+	assert := assert.New(t)
+
+	config := readRegistryConfig(t, `
+registry:
+  host: registry.opentofu.org
+`)
+	resolver := newRegistry(config)
+
+	assert.Equal("https://search.opentofu.org/provider/hashicorp/aws/latest", resolver.providerURL("aws", "", "latest"))
+	assert.Equal("https://search.opentofu.org/provider/hashicorp/aws/latest", resolver.providerURL("aws", "hashicorp/aws", "latest"))
+	assert.Equal("https://registry.terraform.io/providers/hashicorp/aws/latest", resolver.providerURL("aws", "registry.terraform.io/hashicorp/aws", "latest"))
+}
+
+func TestLoadRegistry(t *testing.T) {
+	assert := assert.New(t)
+
+	config := readRegistryConfig(t, `
+registry:
+  providers:
+    - name: tls
+      provider: "https://docs.acme.com/{{ .Name }}"
+      resource: "https://docs.acme.com/{{ .Name }}/{{ .Type }}"
+`)
+	config.ModuleRoot = filepath.Join("testdata", "full-example")
+
+	module, err := loadModule(config.ModuleRoot)
+	assert.Nil(err)
+
+	resources := loadResources(module, config, newHCLFiles())
+	providers := loadProviders(module, config)
+
+	urls := make([]string, 0)
+	for _, r := range resources {
+		urls = append(urls, r.URL())
+	}
+	for _, p := range providers {
+		urls = append(urls, p.URL())
+	}
+	assert.Contains(urls, "https://docs.acme.com/tls/private_key")
+	assert.Contains(urls, "https://docs.acme.com/tls")
+
+	assert.Nil(loadRegistry(print.DefaultConfig()))
+	assert.NotNil(loadRegistry(config))
+}
+
+func readRegistryConfig(t *testing.T, content string) *print.Config {
+	dir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(dir, ".terraform-docs.yml"), []byte("formatter: markdown table\nsort:\n  by: name\n"+content), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := print.ReadConfig(dir, ".terraform-docs.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return config
+}
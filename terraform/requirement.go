@@ -14,8 +14,12 @@ import (
 	"github.com/terraform-docs/terraform-docs/internal/types"
 )
 
-// Requirement represents a requirement for Terraform module.
+// Requirement represents a requirement for Terraform module, i.e. Terraform
+// itself or a provider declared in 'required_providers' block, with all of its
+// version constraints merged together.
 type Requirement struct {
-	Name    string       `json:"name" toml:"name" xml:"name" yaml:"name"`
-	Version types.String `json:"version" toml:"version" xml:"version" yaml:"version"`
+	Name                 string       `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Version              types.String `json:"version" toml:"version" xml:"version" yaml:"version"`
+	Source               types.String `json:"source" toml:"source" xml:"source" yaml:"source"`
+	ConfigurationAliases []string     `json:"configuration_aliases" toml:"configuration_aliases" xml:"configuration_aliases>alias" yaml:"configuration_aliases"`
 }
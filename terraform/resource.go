@@ -11,9 +11,7 @@ the root directory of this source tree.
 package terraform
 
 import (
-	"fmt"
 	"sort"
-	"strings"
 
 	"github.com/terraform-docs/terraform-docs/internal/types"
 )
@@ -27,7 +25,15 @@ type Resource struct {
 	Mode           string       `json:"mode" toml:"mode" xml:"mode" yaml:"mode"`
 	Version        types.String `json:"version" toml:"version" xml:"version" yaml:"version"`
 	Description    types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
-	Position       Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
+
+	// MetaArgument is either 'count' or 'for_each' if the resource is repeated
+	// by any of them, and Address is only set with 'settings.nested-resources'.
+	MetaArgument string `json:"meta_argument,omitempty" toml:"meta_argument,omitempty" xml:"meta_argument,omitempty" yaml:"meta_argument,omitempty"`
+	Address      string `json:"address,omitempty" toml:"address,omitempty" xml:"address,omitempty" yaml:"address,omitempty"`
+
+	Position Position `json:"-" toml:"-" xml:"-" yaml:"-"`
+
+	registry *registry
 }
 
 // Spec returns the resource spec addresses a specific resource in the config.
@@ -50,22 +56,14 @@ func (r *Resource) GetMode() string {
 	}
 }
 
-// URL returns a best guess at the URL for resource documentation
+// URL returns the URL of the resource documentation, based on the source of
+// its provider and 'registry' config. It is empty if the URL can't be resolved.
 func (r *Resource) URL() string {
-	kind := ""
-	switch r.Mode {
-	case "managed":
-		kind = "resources"
-	case "data":
-		kind = "data-sources"
-	default:
-		return ""
-	}
-
-	if strings.Count(r.ProviderSource, "/") > 1 {
-		return ""
+	resolver := r.registry
+	if resolver == nil {
+		resolver = defaultRegistry
 	}
-	return fmt.Sprintf("https://registry.terraform.io/providers/%s/%s/docs/%s/%s", r.ProviderSource, r.Version, kind, r.Type)
+	return resolver.resourceURL(r.ProviderName, r.ProviderSource, string(r.Version), r.Mode, r.Type)
 }
 
 func sortResourcesByType(x []*Resource) {
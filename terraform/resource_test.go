@@ -91,6 +91,36 @@ func TestResourceURL(t *testing.T) {
 			},
 			expectValue: "https://registry.terraform.io/providers/hashicorp/tls/latest/docs/resources/private_key",
 		},
+		"Fully qualified source": {
+			resource: Resource{
+				Type:           "instance",
+				ProviderName:   "aws",
+				ProviderSource: "registry.terraform.io/hashicorp/aws",
+				Mode:           "data",
+				Version:        types.String("5.0.0"),
+			},
+			expectValue: "https://registry.terraform.io/providers/hashicorp/aws/5.0.0/docs/data-sources/instance",
+		},
+		"OpenTofu registry": {
+			resource: Resource{
+				Type:           "password",
+				ProviderName:   "random",
+				ProviderSource: "registry.opentofu.org/opentofu/random",
+				Mode:           "managed",
+				Version:        types.String("latest"),
+			},
+			expectValue: "https://search.opentofu.org/provider/opentofu/random/latest/docs/resources/password",
+		},
+		"Unknown registry host": {
+			resource: Resource{
+				Type:           "bar",
+				ProviderName:   "foo",
+				ProviderSource: "registry.acme.com/acme/foo",
+				Mode:           "managed",
+				Version:        types.String("latest"),
+			},
+			expectValue: "",
+		},
 		"Unable to construct URL": {
 			resource: Resource{
 				Type:           "custom",
@@ -0,0 +1,217 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/terraform-docs/terraform-docs/internal/reader"
+)
+
+// sectionSource is one of the comma separated sources of 'header-from' or
+// 'footer-from', i.e. a file with an optional name of a region or a Markdown
+// heading in it, e.g. 'README.md#usage'.
+type sectionSource struct {
+	file string
+	name string
+}
+
+func (s sectionSource) String() string {
+	if s.name == "" {
+		return s.file
+	}
+	return s.file + "#" + s.name
+}
+
+// parseSectionSources returns the sources of 'header-from' or 'footer-from'
+// value, in the order they are concatenated.
+func parseSectionSources(value string) []sectionSource {
+	var sources = make([]sectionSource, 0)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		file, name, _ := strings.Cut(item, "#")
+		sources = append(sources, sectionSource{
+			file: strings.TrimSpace(file),
+			name: strings.TrimSpace(name),
+		})
+	}
+	return sources
+}
+
+// lineCommentsSource is the name of the source of a '.tf' or '.tofu' file to
+// read its header from consecutive '#' or '//' lines, e.g. 'main.tf#comments'.
+// They are not read by default, as they are commonly used for license notices.
+const lineCommentsSource = "comments"
+
+// headerComment returns the reader of the comment at the very top of a '.tf'
+// or '.tofu' file, i.e. a '/* ... */' block or, if 'lineComments' is set,
+// consecutive '#' or '//' lines.
+func headerComment(filename string, lineComments bool) reader.Lines {
+	prefixes := []string{"/*"}
+	if lineComments {
+		prefixes = []string{"//", "#"}
+	}
+	var prefix string
+	return reader.Lines{
+		FileName: filename,
+		LineNum:  -1,
+		Condition: func(line string) bool {
+			line = strings.TrimSpace(line)
+			if prefix == "" {
+				for _, p := range prefixes {
+					if strings.HasPrefix(line, p) {
+						prefix = p
+						break
+					}
+				}
+			}
+			if prefix == "/*" {
+				return strings.HasPrefix(line, "/*") || strings.HasPrefix(line, "*") || strings.HasPrefix(line, "*/")
+			}
+			return prefix != "" && strings.HasPrefix(line, prefix)
+		},
+		Parser: func(line string) (string, bool) {
+			tmp := strings.TrimSpace(line)
+			if prefix != "/*" {
+				tmp = strings.TrimPrefix(tmp, prefix)
+				return strings.TrimPrefix(tmp, " "), true
+			}
+			if strings.HasPrefix(tmp, "/*") || strings.HasPrefix(tmp, "*/") {
+				return "", false
+			}
+			if tmp == "*" {
+				return "", true
+			}
+			line = strings.TrimLeft(line, " ")
+			line = strings.TrimRight(line, "\r\n")
+			line = strings.TrimPrefix(line, "* ")
+			return line, true
+		},
+	}
+}
+
+// stripFrontMatter removes YAML front matter, i.e. the lines between '---' at
+// the very first line and the next '---', from the content.
+func stripFrontMatter(content string) string {
+	lines := strings.SplitAfter(content, "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != "---" {
+		return content
+	}
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == "---" {
+			return strings.TrimLeft(strings.Join(lines[i+1:], ""), "\r\n")
+		}
+	}
+	return content
+}
+
+// regionMarker matches the '<name>:start' and '<name>:end' markers of a region,
+// put in any kind of comment.
+var regionMarker = regexp.MustCompile(`^\s*(?:<!--|#|//|/\*)\s*(.+?):(start|end)\s*(?:-->|\*/)?\s*$`)
+
+// extractRegion returns the lines between '<name>:start' and '<name>:end'
+// markers of the content. Markers can be put in any kind of comment, i.e.
+// '<!-- header:start -->', '# header:start', '// header:start' or
+// '/* header:start */'.
+func extractRegion(content string, name string) (string, bool, error) {
+	isMarker := func(line string, kind string) bool {
+		match := regionMarker.FindStringSubmatch(line)
+		return match != nil && match[1] == name && match[2] == kind
+	}
+
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		if !isMarker(line, "start") {
+			continue
+		}
+		for j := i + 1; j < len(lines); j++ {
+			if isMarker(lines[j], "end") {
+				return trimBlankLines(lines[i+1 : j]), true, nil
+			}
+		}
+		return "", false, fmt.Errorf("'%s:end' marker is missing", name)
+	}
+	return "", false, nil
+}
+
+var (
+	markdownHeading = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	markdownFence   = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})")
+)
+
+// extractHeading returns the content under the Markdown heading matching the
+// name, by its text or its anchor (e.g. 'Getting Started' or 'getting-started'),
+// up to the next heading of the same or a higher level. The heading itself
+// is not included, and neither are lines of fenced ('```' or '~~~') or
+// indented code blocks considered to be headings.
+func extractHeading(content string, name string) (string, bool) {
+	lines := strings.Split(content, "\n")
+	level := 0
+	start := -1
+	fence := "" // opening fence of the code block the line is in, if any
+	for i, line := range lines {
+		line = strings.TrimRight(line, "\r")
+		if match := markdownFence.FindStringSubmatch(line); match != nil {
+			switch {
+			case fence == "":
+				fence = match[1]
+			case match[1][0] == fence[0] && len(match[1]) >= len(fence) && strings.TrimSpace(line) == match[1]:
+				fence = ""
+			}
+			continue
+		}
+		if fence != "" {
+			continue
+		}
+		match := markdownHeading.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		if start != -1 {
+			if len(match[1]) <= level {
+				return trimBlankLines(lines[start:i]), true
+			}
+			continue
+		}
+		if strings.EqualFold(match[2], name) || headingAnchor(match[2]) == strings.ToLower(name) {
+			level = len(match[1])
+			start = i + 1
+		}
+	}
+	if start == -1 {
+		return "", false
+	}
+	return trimBlankLines(lines[start:]), true
+}
+
+// headingAnchor returns the anchor of a Markdown heading the way it's
+// generated by GitHub, e.g. 'Getting Started' becomes 'getting-started'.
+func headingAnchor(heading string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(heading) {
+		switch {
+		case r == ' ':
+			b.WriteRune('-')
+		case r == '-' || r == '_' || ('a' <= r && r <= 'z') || ('0' <= r && r <= '9'):
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func trimBlankLines(lines []string) string {
+	return strings.Trim(strings.Join(lines, "\n"), "\r\n")
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSectionSources(t *testing.T) {
+	tests := map[string]struct {
+		value    string
+		expected []sectionSource
+	}{
+		"Empty": {
+			value:    " , ",
+			expected: []sectionSource{},
+		},
+		"SingleFile": {
+			value:    "main.tf",
+			expected: []sectionSource{{file: "main.tf"}},
+		},
+		"NamedSource": {
+			value:    "docs/README.md#usage",
+			expected: []sectionSource{{file: "docs/README.md", name: "usage"}},
+		},
+		"MultipleSources": {
+			value: "main.tf, README.md#Getting Started ,footer.md",
+			expected: []sectionSource{
+				{file: "main.tf"},
+				{file: "README.md", name: "Getting Started"},
+				{file: "footer.md"},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, parseSectionSources(tt.value))
+		})
+	}
+}
+
+func TestStripFrontMatter(t *testing.T) {
+	tests := map[string]struct {
+		content  string
+		expected string
+	}{
+		"NoFrontMatter": {
+			content:  "# Title\n\n---\n\ncontent\n",
+			expected: "# Title\n\n---\n\ncontent\n",
+		},
+		"FrontMatter": {
+			content:  "---\ntitle: foo\n---\n\n# Title\n",
+			expected: "# Title\n",
+		},
+		"FrontMatterCRLF": {
+			content:  "---\r\ntitle: foo\r\n---\r\n\r\n# Title\r\n",
+			expected: "# Title\r\n",
+		},
+		"UnterminatedFrontMatter": {
+			content:  "---\ntitle: foo\n",
+			expected: "---\ntitle: foo\n",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, stripFrontMatter(tt.content))
+		})
+	}
+}
+
+func TestExtractRegion(t *testing.T) {
+	tests := map[string]struct {
+		content  string
+		name     string
+		expected string
+		found    bool
+		wantErr  bool
+	}{
+		"HTMLComment": {
+			content:  "foo\n<!-- header:start -->\nbar\n<!-- header:end -->\nbaz",
+			name:     "header",
+			expected: "bar",
+			found:    true,
+		},
+		"HashComment": {
+			content:  "# header:start\nbar\n# header:end",
+			name:     "header",
+			expected: "bar",
+			found:    true,
+		},
+		"BlockComment": {
+			content:  "/* header:start */\n\nbar\n\n/* header:end */",
+			name:     "header",
+			expected: "bar",
+			found:    true,
+		},
+		"OtherRegion": {
+			content: "<!-- footer:start -->\nbar\n<!-- footer:end -->",
+			name:    "header",
+			found:   false,
+		},
+		"SpecialCharacters": {
+			content:  "<!-- a.b:start -->\nfoo\n<!-- a.b:end -->\n<!-- a+b:start -->\nbar\n<!-- a+b:end -->",
+			name:     "a+b",
+			expected: "bar",
+			found:    true,
+		},
+		"SimilarName": {
+			content: "<!-- axb:start -->\nbar\n<!-- axb:end -->",
+			name:    "a.b",
+			found:   false,
+		},
+		"MissingEnd": {
+			content: "<!-- header:start -->\nbar",
+			name:    "header",
+			found:   false,
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, found, err := extractRegion(tt.content, tt.name)
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+			}
+			assert.Equal(tt.found, found)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestExtractHeading(t *testing.T) {
+	content := "# Title\n\nintro\n\n## Getting Started\n\nfoo\n\n### Nested\n\nbar\n\n## Usage ##\n\nbaz\n"
+
+	tests := map[string]struct {
+		name     string
+		expected string
+		found    bool
+	}{
+		"ByText": {
+			name:     "getting started",
+			expected: "foo\n\n### Nested\n\nbar",
+			found:    true,
+		},
+		"ByAnchor": {
+			name:     "getting-started",
+			expected: "foo\n\n### Nested\n\nbar",
+			found:    true,
+		},
+		"ClosedHeading": {
+			name:     "Usage",
+			expected: "baz",
+			found:    true,
+		},
+		"TopLevel": {
+			name:     "title",
+			expected: "intro\n\n## Getting Started\n\nfoo\n\n### Nested\n\nbar\n\n## Usage ##\n\nbaz",
+			found:    true,
+		},
+		"Missing": {
+			name:  "license",
+			found: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, found := extractHeading(content, tt.name)
+			assert.Equal(tt.found, found)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestExtractHeadingCodeBlocks(t *testing.T) {
+	tests := map[string]struct {
+		content  string
+		expected string
+	}{
+		"BacktickFence": {
+			content:  "## Usage\n\n```sh\n# comment\n```\n\nfoo\n\n## Next\n",
+			expected: "```sh\n# comment\n```\n\nfoo",
+		},
+		"TildeFence": {
+			content:  "## Usage\n\n~~~sh\n# comment\n~~~\n\nfoo\n\n## Next\n",
+			expected: "~~~sh\n# comment\n~~~\n\nfoo",
+		},
+		"MixedFences": {
+			content:  "## Usage\n\n````md\n```\n# comment\n~~~\n````\n\nfoo\n\n## Next\n",
+			expected: "````md\n```\n# comment\n~~~\n````\n\nfoo",
+		},
+		"IndentedCode": {
+			content:  "## Usage\n\n    # comment\n    ```\n\nfoo\n\n## Next\n",
+			expected: "    # comment\n    ```\n\nfoo",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, found := extractHeading(tt.content, "usage")
+			assert.True(found)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
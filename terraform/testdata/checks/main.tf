@@ -0,0 +1,49 @@
+resource "null_resource" "main" {
+  triggers = {
+    name = var.name
+  }
+
+  lifecycle {
+    precondition {
+      condition     = length(var.name) > 0
+      error_message = "The name must not be empty."
+    }
+
+    postcondition {
+      condition     = self.id != ""
+      error_message = "The resource must have an ID."
+    }
+  }
+}
+
+data "http" "health" {
+  url = "https://example.com/health"
+
+  lifecycle {
+    postcondition {
+      condition     = contains([200, 204], self.status_code)
+      error_message = "The service must be healthy."
+    }
+  }
+}
+
+# terraform-docs-ignore
+resource "null_resource" "ignored" {
+  lifecycle {
+    precondition {
+      condition     = true
+      error_message = "Ignored."
+    }
+  }
+}
+
+check "health" {
+  data "http" "status" {
+    url = "https://example.com/status"
+  }
+
+  assert {
+    condition     = data.http.status.status_code == 200 || data.http.status.status_code == 204
+    error_message = "${data.http.status.url} returned an unhealthy status code."
+  }
+}
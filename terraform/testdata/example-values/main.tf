@@ -0,0 +1,28 @@
+variable "name" {
+  description = "Name of the resource."
+  type        = string
+}
+
+variable "tags" {
+  description = "Tags to apply to the resource."
+  type        = map(string)
+  default     = {}
+}
+
+variable "zones" {
+  description = "Availability zones of the resource."
+  type        = list(string)
+  default     = ["eu-west-1a"]
+}
+
+variable "retries" {
+  description = "Number of retries."
+  type        = number
+  default     = 3
+}
+
+variable "password" {
+  description = "Password of the resource."
+  type        = string
+  sensitive   = true
+}
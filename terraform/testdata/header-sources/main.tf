@@ -0,0 +1,7 @@
+# # Main title
+#
+# Header written with `#` line comments.
+#
+#     indented code block
+
+resource "null_resource" "this" {}
@@ -0,0 +1,7 @@
+// Header written with `//` line comments.
+//
+// - list item 1
+// - list item 2
+terraform {
+  required_version = ">= 1.0"
+}
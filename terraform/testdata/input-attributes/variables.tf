@@ -0,0 +1,26 @@
+variable "password" {
+  description = "Password of the admin user."
+  type        = string
+  sensitive   = true
+  nullable    = false
+}
+
+variable "session_token" {
+  description = "Short-lived session token."
+  type        = string
+  default     = null
+  ephemeral   = true
+}
+
+variable "region" {
+  description = "Region to deploy to."
+  type        = string
+  default     = "eu-west-1"
+  nullable    = false
+}
+
+variable "tags" {
+  description = "Tags to attach."
+  type        = map(string)
+  default     = {}
+}
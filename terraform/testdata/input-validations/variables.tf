@@ -0,0 +1,18 @@
+variable "name" {
+  type = string
+
+  validation {
+    condition     = length(var.name) > 3
+    error_message = "The name must be longer than 3 characters."
+  }
+
+  validation {
+    condition     = can(regex("^[a-z]+$", var.name))
+    error_message = "The name ${var.name} must be lowercase."
+  }
+}
+
+variable "size" {
+  type    = string
+  default = "small"
+}
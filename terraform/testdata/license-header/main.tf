@@ -0,0 +1,6 @@
+# Copyright 2024 Acme Corp
+# SPDX-License-Identifier: MIT
+
+variable "foo" {
+  type = string
+}
@@ -0,0 +1,24 @@
+terraform {
+  required_version = ">= 1.0"
+
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = ">= 4.0, < 6.0"
+    }
+    acme = {
+      source = "registry.acme.com/acme/aws"
+    }
+    random = {
+      source = "hashicorp/random"
+    }
+  }
+}
+
+resource "aws_s3_bucket" "this" {}
+
+resource "acme_aws_account" "this" {}
+
+resource "random_id" "this" {
+  byte_length = 8
+}
@@ -0,0 +1,9 @@
+import {
+  to = null_resource.bar
+  id = "bar-id"
+}
+
+import {
+  to = null_resource.qux
+  id = var.qux_id
+}
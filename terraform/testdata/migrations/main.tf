@@ -0,0 +1,26 @@
+resource "null_resource" "bar" {}
+
+# Renamed in v2.0.0
+moved {
+  from = null_resource.foo
+  to   = null_resource.bar
+}
+
+moved {
+  from = module.old["a"]
+  to   = module.new["a"]
+}
+
+removed {
+  from = null_resource.baz
+
+  lifecycle {
+    destroy = false
+  }
+}
+
+# terraform-docs-ignore
+moved {
+  from = null_resource.ignored
+  to   = null_resource.bar
+}
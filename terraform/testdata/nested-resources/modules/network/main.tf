@@ -0,0 +1,25 @@
+variable "subnets" {
+  type    = map(string)
+  default = {}
+}
+
+resource "aws_vpc" "this" {}
+
+resource "aws_subnet" "this" {
+  for_each = var.subnets
+
+  vpc_id     = aws_vpc.this.id
+  cidr_block = each.value
+}
+
+data "aws_availability_zones" "available" {}
+
+module "security" {
+  source = "../security"
+  count  = 1
+}
+
+# cyclic reference, which must not be followed
+module "root" {
+  source = "../.."
+}
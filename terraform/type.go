@@ -0,0 +1,308 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// List of supported kinds of type constraint.
+const (
+	TypeString = "string"
+	TypeNumber = "number"
+	TypeBool   = "bool"
+	TypeAny    = "any"
+	TypeList   = "list"
+	TypeSet    = "set"
+	TypeMap    = "map"
+	TypeTuple  = "tuple"
+	TypeObject = "object"
+)
+
+// TypeSchema represents the structured form of a Terraform type constraint.
+//
+// Primitive kinds (string, number, bool and any) have no children, collection
+// kinds (list, set and map) have an 'Element' type, tuples have a list of
+// 'Elements' and objects have a list of 'Attributes'.
+type TypeSchema struct {
+	Kind       string           `json:"kind" toml:"kind" xml:"kind" yaml:"kind"`
+	Element    *TypeSchema      `json:"element,omitempty" toml:"element,omitempty" xml:"element,omitempty" yaml:"element,omitempty"`
+	Elements   []*TypeSchema    `json:"elements,omitempty" toml:"elements,omitempty" xml:"elements,omitempty" yaml:"elements,omitempty"`
+	Attributes []*TypeAttribute `json:"attributes,omitempty" toml:"attributes,omitempty" xml:"attribute,omitempty" yaml:"attributes,omitempty"`
+}
+
+// TypeAttribute represents an attribute of an object type constraint.
+type TypeAttribute struct {
+	Name        string       `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Description types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
+	Type        *TypeSchema  `json:"type" toml:"type" xml:"type" yaml:"type"`
+	Optional    bool         `json:"optional" toml:"optional" xml:"optional" yaml:"optional"`
+	Default     types.Value  `json:"default,omitempty" toml:"default,omitempty" xml:"default,omitempty" yaml:"default,omitempty"`
+}
+
+// NestedAttribute represents an attribute of a (possibly nested) object type
+// constraint together with its path relative to the input, e.g. 'rules[*].name'.
+type NestedAttribute struct {
+	*TypeAttribute
+
+	Path string
+}
+
+// GetValue returns compact JSON representation of the 'Default' value of the
+// attribute. It returns an empty string for required attributes and 'null' for
+// optional attributes without a default value.
+func (a *TypeAttribute) GetValue() string {
+	if !a.Optional {
+		return ""
+	}
+	if a.Default == nil {
+		return `null`
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(a.Default); err != nil {
+		panic(err)
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+// IsPrimitive indicates if the type is a primitive type (i.e. it has no
+// nested types).
+func (t *TypeSchema) IsPrimitive() bool {
+	switch t.Kind {
+	case TypeString, TypeNumber, TypeBool, TypeAny:
+		return true
+	}
+	return false
+}
+
+// HasAttributes indicates if the type is an object type with attributes.
+func (t *TypeSchema) HasAttributes() bool {
+	return len(t.Attributes) > 0
+}
+
+// HasAttributeDescriptions indicates if any attribute of the type, at any
+// level of nesting, is documented.
+func (t *TypeSchema) HasAttributeDescriptions() bool {
+	for _, a := range t.NestedAttributes() {
+		if a.Description != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// NestedAttributes returns the flattened list of attributes of the type, at
+// any level of nesting, in the order they are declared. Elements of list, set,
+// map and tuple types are denoted with '[*]' in the attribute path.
+func (t *TypeSchema) NestedAttributes() []*NestedAttribute {
+	attributes := make([]*NestedAttribute, 0)
+	t.walk("", func(path string, a *TypeAttribute) {
+		attributes = append(attributes, &NestedAttribute{TypeAttribute: a, Path: path})
+	})
+	return attributes
+}
+
+func (t *TypeSchema) walk(prefix string, fn func(path string, a *TypeAttribute)) {
+	if t == nil {
+		return
+	}
+	switch t.Kind {
+	case TypeObject:
+		for _, a := range t.Attributes {
+			path := a.Name
+			if prefix != "" {
+				path = prefix + "." + a.Name
+			}
+			fn(path, a)
+			a.Type.walk(path, fn)
+		}
+	case TypeList, TypeSet, TypeMap:
+		t.Element.walk(prefix+"[*]", fn)
+	case TypeTuple:
+		for _, e := range t.Elements {
+			e.walk(prefix+"[*]", fn)
+		}
+	}
+}
+
+// String returns the canonical representation of the type constraint. Types
+// of object attributes are not expanded, as they are listed separately.
+func (t *TypeSchema) String() string {
+	switch t.Kind {
+	case TypeList, TypeSet, TypeMap:
+		return t.Kind + "(" + t.Element.String() + ")"
+	case TypeTuple:
+		elements := make([]string, 0, len(t.Elements))
+		for _, e := range t.Elements {
+			elements = append(elements, e.String())
+		}
+		return t.Kind + "([" + strings.Join(elements, ", ") + "])"
+	}
+	return t.Kind
+}
+
+// loadTypeSchema returns the structured type constraint of the 'type'
+// attribute of the given variable block. It returns nil if the block doesn't
+// have a type constraint or if it can't be parsed.
+func loadTypeSchema(b *block) *TypeSchema {
+	if b == nil {
+		return nil
+	}
+	attr, ok := b.attribute("type")
+	if !ok {
+		return nil
+	}
+	p := &typeParser{comments: b.comments()}
+	return p.parse(attr.Expr)
+}
+
+// typeParser parses type constraint expressions into their structured form
+// and documents object attributes with the comments attached to them.
+type typeParser struct {
+	comments *comments
+}
+
+// parse parses a type constraint expression into its structured form, or
+// returns nil if the expression is not a valid type constraint.
+func (p *typeParser) parse(expr hclsyntax.Expression) *TypeSchema {
+	switch e := expr.(type) {
+	case *hclsyntax.ScopeTraversalExpr:
+		switch kind := hcl.ExprAsKeyword(e); kind {
+		case TypeString, TypeNumber, TypeBool, TypeAny:
+			return &TypeSchema{Kind: kind}
+		}
+	case *hclsyntax.FunctionCallExpr:
+		return p.parseCall(e)
+	case *hclsyntax.TemplateExpr:
+		// legacy quoted type constraints (i.e. Terraform 0.11 and earlier)
+		if !e.IsStringLiteral() {
+			return nil
+		}
+		value, _ := e.Value(nil)
+		switch kind := value.AsString(); kind {
+		case TypeString:
+			return &TypeSchema{Kind: kind}
+		case TypeList, TypeMap:
+			return &TypeSchema{Kind: kind, Element: &TypeSchema{Kind: TypeAny}}
+		}
+	}
+	return nil
+}
+
+func (p *typeParser) parseCall(call *hclsyntax.FunctionCallExpr) *TypeSchema {
+	if len(call.Args) != 1 {
+		return nil
+	}
+	switch call.Name {
+	case TypeList, TypeSet, TypeMap:
+		element := p.parse(call.Args[0])
+		if element == nil {
+			return nil
+		}
+		return &TypeSchema{Kind: call.Name, Element: element}
+	case TypeTuple:
+		tuple, ok := call.Args[0].(*hclsyntax.TupleConsExpr)
+		if !ok {
+			return nil
+		}
+		elements := make([]*TypeSchema, 0, len(tuple.Exprs))
+		for _, expr := range tuple.Exprs {
+			element := p.parse(expr)
+			if element == nil {
+				return nil
+			}
+			elements = append(elements, element)
+		}
+		return &TypeSchema{Kind: TypeTuple, Elements: elements}
+	case TypeObject:
+		object, ok := call.Args[0].(*hclsyntax.ObjectConsExpr)
+		if !ok {
+			return nil
+		}
+		attributes := make([]*TypeAttribute, 0, len(object.Items))
+		for _, item := range object.Items {
+			attribute := p.parseAttribute(item)
+			if attribute == nil {
+				return nil
+			}
+			attributes = append(attributes, attribute)
+		}
+		return &TypeSchema{Kind: TypeObject, Attributes: attributes}
+	}
+	return nil
+}
+
+func (p *typeParser) parseAttribute(item hclsyntax.ObjectConsItem) *TypeAttribute {
+	name := hcl.ExprAsKeyword(item.KeyExpr)
+	if name == "" {
+		value, diags := item.KeyExpr.Value(nil)
+		if diags.HasErrors() || !value.Type().Equals(cty.String) || value.IsNull() {
+			return nil
+		}
+		name = value.AsString()
+	}
+
+	attribute := &TypeAttribute{
+		Name:        name,
+		Description: types.String(p.comments.attached(item.KeyExpr.Range().Start.Line)),
+	}
+
+	call, ok := item.ValueExpr.(*hclsyntax.FunctionCallExpr)
+	if !ok || call.Name != "optional" {
+		attribute.Type = p.parse(item.ValueExpr)
+		if attribute.Type == nil {
+			return nil
+		}
+		return attribute
+	}
+
+	if len(call.Args) < 1 || len(call.Args) > 2 {
+		return nil
+	}
+	attribute.Type = p.parse(call.Args[0])
+	if attribute.Type == nil {
+		return nil
+	}
+	attribute.Optional = true
+	if len(call.Args) == 2 {
+		attribute.Default = evalTypeDefault(call.Args[1])
+	}
+	return attribute
+}
+
+// evalTypeDefault evaluates the default value of an optional attribute. It
+// returns nil if the value can't be statically evaluated.
+func evalTypeDefault(expr hclsyntax.Expression) types.Value {
+	value, diags := expr.Value(nil)
+	if diags.HasErrors() || !value.IsWhollyKnown() {
+		return nil
+	}
+	raw, err := ctyjson.SimpleJSONValue{Value: value}.MarshalJSON()
+	if err != nil {
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil
+	}
+	return types.ValueOf(v)
+}
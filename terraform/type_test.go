@@ -0,0 +1,195 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+	"github.com/terraform-docs/terraform-docs/print"
+)
+
+func TestLoadTypeSchema(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected *TypeSchema
+	}{
+		{
+			name:     "load primitive type",
+			input:    "name",
+			expected: &TypeSchema{Kind: TypeString},
+		},
+		{
+			name:  "load legacy quoted type",
+			input: "legacy",
+			expected: &TypeSchema{
+				Kind:    TypeList,
+				Element: &TypeSchema{Kind: TypeAny},
+			},
+		},
+		{
+			name:  "load collection type",
+			input: "ports",
+			expected: &TypeSchema{
+				Kind:    TypeSet,
+				Element: &TypeSchema{Kind: TypeNumber},
+			},
+		},
+		{
+			name:  "load tuple type",
+			input: "pair",
+			expected: &TypeSchema{
+				Kind: TypeTuple,
+				Elements: []*TypeSchema{
+					{Kind: TypeString},
+					{Kind: TypeBool},
+				},
+			},
+		},
+		{
+			name:     "load input without type",
+			input:    "untyped",
+			expected: nil,
+		},
+		{
+			name:  "load nested object type",
+			input: "settings",
+			expected: &TypeSchema{
+				Kind: TypeObject,
+				Attributes: []*TypeAttribute{
+					{
+						Name:        "enabled",
+						Description: "Whether the feature is enabled.",
+						Type:        &TypeSchema{Kind: TypeBool},
+					},
+					{
+						Name:     "retention-days",
+						Type:     &TypeSchema{Kind: TypeNumber},
+						Optional: true,
+						Default:  types.Number(7),
+					},
+					{
+						Name:        "tags",
+						Description: "Additional tags.",
+						Type:        &TypeSchema{Kind: TypeMap, Element: &TypeSchema{Kind: TypeString}},
+						Optional:    true,
+					},
+					{
+						Name: "rules",
+						Type: &TypeSchema{
+							Kind: TypeList,
+							Element: &TypeSchema{
+								Kind: TypeObject,
+								Attributes: []*TypeAttribute{
+									{
+										Name: "name",
+										Type: &TypeSchema{Kind: TypeString},
+									},
+									{
+										Name:     "priority",
+										Type:     &TypeSchema{Kind: TypeNumber},
+										Optional: true,
+										Default:  types.Number(100),
+									},
+									{
+										Name:     "ports",
+										Type:     &TypeSchema{Kind: TypeList, Element: &TypeSchema{Kind: TypeNumber}},
+										Optional: true,
+										Default:  types.List{float64(80), float64(443)},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := print.NewConfig()
+			module, _ := loadModule(filepath.Join("testdata", "input-types"))
+			inputs, _, _ := loadInputs(module, config, newHCLFiles())
+
+			var input *Input
+			for _, i := range inputs {
+				if i.Name == tt.input {
+					input = i
+				}
+			}
+
+			assert.NotNil(input)
+			assert.Equal(tt.expected, input.TypeSchema)
+		})
+	}
+}
+
+func TestTypeSchemaIsPrimitive(t *testing.T) {
+	tests := map[string]bool{
+		TypeString: true,
+		TypeNumber: true,
+		TypeBool:   true,
+		TypeAny:    true,
+		TypeList:   false,
+		TypeSet:    false,
+		TypeMap:    false,
+		TypeTuple:  false,
+		TypeObject: false,
+	}
+	for kind, expected := range tests {
+		t.Run(kind, func(t *testing.T) {
+			assert := assert.New(t)
+			schema := &TypeSchema{Kind: kind}
+			assert.Equal(expected, schema.IsPrimitive())
+		})
+	}
+}
+
+func TestTypeSchemaNestedAttributes(t *testing.T) {
+	assert := assert.New(t)
+
+	schema := &TypeSchema{
+		Kind: TypeObject,
+		Attributes: []*TypeAttribute{
+			{Name: "name", Type: &TypeSchema{Kind: TypeString}},
+			{
+				Name: "rules",
+				Type: &TypeSchema{
+					Kind: TypeMap,
+					Element: &TypeSchema{
+						Kind: TypeObject,
+						Attributes: []*TypeAttribute{
+							{Name: "port", Description: "Port of the rule.", Type: &TypeSchema{Kind: TypeNumber}},
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	paths := make([]string, 0)
+	for _, a := range schema.NestedAttributes() {
+		paths = append(paths, a.Path)
+	}
+
+	assert.Equal([]string{"name", "rules", "rules[*].port"}, paths)
+	assert.True(schema.HasAttributeDescriptions())
+	assert.Equal("map(object)", schema.Attributes[1].Type.String())
+	assert.Equal("", schema.Attributes[0].GetValue())
+	assert.Equal("null", schema.Attributes[1].GetValue())
+}